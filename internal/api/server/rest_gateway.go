@@ -17,19 +17,27 @@ import (
 
 	"github.com/rishansujesh/job-scheduler/internal/api/proto"
 	redisx "github.com/rishansujesh/job-scheduler/internal/redis"
+	"github.com/rishansujesh/job-scheduler/internal/worker/handlers"
 )
 
-// StartServers starts gRPC on grpcAddr and REST gateway on httpAddr.
-func StartServers(db *sql.DB, rdb *redis.Client, httpAddr, grpcAddr string) error {
+// StartServers starts gRPC on grpcAddr and REST gateway on httpAddr. reg, if
+// non-nil, replaces the built-in handler registry used to validate jobs.
+func StartServers(db *sql.DB, rdb *redis.Client, reg *handlers.Registry, httpAddr, grpcAddr string) error {
 	// gRPC server (in-process)
 	grpcServer := grpc.NewServer()
 	js := New(db, rdb, redisx.StreamsFromEnv())
+	if reg != nil {
+		js.Handlers = reg
+	}
 	proto.RegisterJobServiceServer(grpcServer, js)
 
 	// REST gateway connects to the in-process gRPC via local dial
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	// Outbox relay publishes runs committed by RunJob
+	go js.Relay.Run(ctx)
+
 	mux := runtime.NewServeMux()
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
 	if err := proto.RegisterJobServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
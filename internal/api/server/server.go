@@ -5,35 +5,52 @@ import (
 	"database/sql"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"log"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/rishansujesh/job-scheduler/internal/api/proto"
 	"github.com/rishansujesh/job-scheduler/internal/jobs"
+	"github.com/rishansujesh/job-scheduler/internal/outbox"
 	redisx "github.com/rishansujesh/job-scheduler/internal/redis"
+	"github.com/rishansujesh/job-scheduler/internal/schedule"
+	"github.com/rishansujesh/job-scheduler/internal/worker/handlers"
+	"github.com/rishansujesh/job-scheduler/internal/workflow"
 )
 
 type Server struct {
 	proto.UnimplementedJobServiceServer
-	DB      *sql.DB
-	Store   *jobs.Store
-	RDB     redisClient
-	Streams redisx.StreamsConfig
+	DB        *sql.DB
+	Store     *jobs.Store
+	RDB       *redis.Client
+	Streams   redisx.StreamsConfig
+	Relay     *outbox.Relay
+	Handlers  *handlers.Registry // validates job args per handler
+	Slots     *redisx.Semaphore  // per-job concurrency leases held by workers
+	Workflows *workflow.Engine   // starts workflow runs
 }
 
-type redisClient interface {
-	// minimal subset we use
-}
-
-func New(db *sql.DB, rdb any, streams redisx.StreamsConfig) *Server {
+func New(db *sql.DB, rdb *redis.Client, streams redisx.StreamsConfig) *Server {
+	store := jobs.NewStore(db)
+	relay := outbox.NewRelay(db, rdb, log.Default())
+	wf := workflow.NewEngine(db, store, streams.Adhoc, relay)
+	wf.Tiers = streams
 	return &Server{
-		DB:      db,
-		Store:   jobs.NewStore(db),
-		RDB:     nil, // not used directly; we call redisx helpers with *redis.Client from main.go
-		Streams: streams,
+		DB:        db,
+		Store:     store,
+		RDB:       rdb,
+		Streams:   streams,
+		Relay:     relay,
+		Handlers:  handlers.Builtin(),
+		Slots:     redisx.NewSemaphore(rdb, streams.SlotPrefix, 0),
+		Workflows: wf,
 	}
 }
 
@@ -48,6 +65,17 @@ func parseJSONMap(s string) (map[string]any, error) {
 	return m, nil
 }
 
+// validateArgs checks args against the registered handler.
+func (s *Server) validateArgs(handler string, args map[string]any) error {
+	b, _ := json.Marshal(args)
+	if err := s.Handlers.Validate(handler, b); errors.Is(err, handlers.ErrUnknownHandler) {
+		return status.Errorf(codes.InvalidArgument, "handler: %v (known: %s)", err, strings.Join(s.Handlers.Names(), ", "))
+	} else if err != nil {
+		return status.Errorf(codes.InvalidArgument, "args_json: %v", err)
+	}
+	return nil
+}
+
 /******** Jobs ********/
 
 func (s *Server) CreateJob(ctx context.Context, req *proto.CreateJobRequest) (*proto.CreateJobResponse, error) {
@@ -58,8 +86,31 @@ func (s *Server) CreateJob(ctx context.Context, req *proto.CreateJobRequest) (*p
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "args_json: %v", err)
 	}
+	if err := s.validateArgs(req.GetHandler(), args); err != nil {
+		return nil, err
+	}
+	retry, err := fromProtoRetry(req.GetRetryPolicy())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "retry_policy: %v", err)
+	}
+	if req.GetMaxConcurrency() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "max_concurrency must be >= 0")
+	}
+	priority := jobs.Priority(req.GetPriority())
+	if priority != "" && !priority.Valid() {
+		return nil, status.Error(codes.InvalidArgument, "priority must be high, normal or low")
+	}
+	if !s.Streams.KnownQueue(req.GetQueue()) {
+		return nil, status.Errorf(codes.InvalidArgument, "queue %q is not configured", req.GetQueue())
+	}
+	rateLimit, err := fromProtoRateLimit(req.GetRateLimit(), false)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "rate_limit: %v", err)
+	}
 	j, err := s.Store.CreateJob(ctx, jobs.CreateJobParams{
 		Name: req.GetName(), Type: req.GetType(), Handler: req.GetHandler(), Args: args, Enabled: req.GetEnabled(),
+		RetryPolicy: retry, MaxConcurrency: int(req.GetMaxConcurrency()), Priority: priority,
+		Queue: req.GetQueue(), RateLimit: rateLimit,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "create: %v", err)
@@ -90,6 +141,16 @@ func (s *Server) UpdateJob(ctx context.Context, req *proto.UpdateJobRequest) (*p
 		if err != nil {
 			return nil, status.Errorf(codes.InvalidArgument, "args_json: %v", err)
 		}
+		cur, err := s.Store.GetJob(ctx, req.GetId())
+		if errors.Is(err, jobs.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "job not found")
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "get: %v", err)
+		}
+		if err := s.validateArgs(cur.Handler, m); err != nil {
+			return nil, err
+		}
 		args = &m
 	}
 	var enabled *bool
@@ -97,8 +158,49 @@ func (s *Server) UpdateJob(ctx context.Context, req *proto.UpdateJobRequest) (*p
 		enabled = req.Enabled
 	}
 
+	retry, err := fromProtoRetry(req.GetRetryPolicy())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "retry_policy: %v", err)
+	}
+
+	var maxConc *int
+	if req.MaxConcurrency != nil {
+		if req.GetMaxConcurrency() < 0 {
+			return nil, status.Error(codes.InvalidArgument, "max_concurrency must be >= 0")
+		}
+		n := int(req.GetMaxConcurrency())
+		maxConc = &n
+	}
+
+	var priority *jobs.Priority
+	if req.Priority != nil {
+		p := jobs.Priority(req.GetPriority())
+		if !p.Valid() {
+			return nil, status.Error(codes.InvalidArgument, "priority must be high, normal or low")
+		}
+		priority = &p
+	}
+
+	var queue *string
+	if req.Queue != nil {
+		if !s.Streams.KnownQueue(req.GetQueue()) {
+			return nil, status.Errorf(codes.InvalidArgument, "queue %q is not configured", req.GetQueue())
+		}
+		q := req.GetQueue()
+		if q == "" {
+			q = redisx.DefaultQueue
+		}
+		queue = &q
+	}
+
+	rateLimit, err := fromProtoRateLimit(req.GetRateLimit(), true)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "rate_limit: %v", err)
+	}
+
 	j, err := s.Store.UpdateJob(ctx, jobs.UpdateJobParams{
-		ID: req.GetId(), Name: name, Args: args, Enabled: enabled,
+		ID: req.GetId(), Name: name, Args: args, Enabled: enabled, RetryPolicy: retry, MaxConcurrency: maxConc,
+		Priority: priority, Queue: queue, RateLimit: rateLimit,
 	})
 	if errors.Is(err, jobs.ErrNotFound) {
 		return nil, status.Error(codes.NotFound, "job not found")
@@ -109,86 +211,417 @@ func (s *Server) UpdateJob(ctx context.Context, req *proto.UpdateJobRequest) (*p
 	return &proto.UpdateJobResponse{Job: toProtoJob(*j)}, nil
 }
 
+// ListJobSlots shows which runs currently hold the job's concurrency slots.
+func (s *Server) ListJobSlots(ctx context.Context, req *proto.ListJobSlotsRequest) (*proto.ListJobSlotsResponse, error) {
+	j, err := s.Store.GetJob(ctx, req.GetId())
+	if errors.Is(err, jobs.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "job not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get: %v", err)
+	}
+	holders, err := s.Slots.Holders(ctx, j.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "slots: %v", err)
+	}
+	out := &proto.ListJobSlotsResponse{MaxConcurrency: int32(j.MaxConcurrency)}
+	for _, h := range holders {
+		out.Slots = append(out.Slots, &proto.JobSlot{
+			RunId: h.Holder, WorkerId: h.Owner, ExpiresAt: h.ExpiresAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return out, nil
+}
+
 func (s *Server) DeleteJob(ctx context.Context, req *proto.DeleteJobRequest) (*proto.DeleteJobResponse, error) {
 	if err := s.Store.DisableJob(ctx, req.GetId()); errors.Is(err, jobs.ErrNotFound) {
 		return nil, status.Error(codes.NotFound, "job not found")
 	} else if err != nil {
 		return nil, status.Errorf(codes.Internal, "delete: %v", err)
 	}
+	if req.GetCancelRuns() {
+		runs, err := s.Store.CancelRunsForJob(ctx, req.GetId())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "cancel runs: %v", err)
+		}
+		for _, r := range runs {
+			s.signalCancel(ctx, r)
+		}
+	}
 	return &proto.DeleteJobResponse{}, nil
 }
 
 /******** Ad-hoc run ********/
 
+// RunJob runs a job once. With run_at or delay_seconds the run is stored as
+// pending and the scheduler publishes it when it is due. The run goes to the
+// stream of its job's queue and priority tier: the job's, or the request's
+// override.
 func (s *Server) RunJob(ctx context.Context, req *proto.RunJobRequest) (*proto.RunJobResponse, error) {
-	// Load job to get default args
-	rows, err := s.DB.QueryContext(ctx, `
-SELECT id, name, type, handler, args, enabled, created_at, updated_at
-FROM jobs WHERE id = $1 AND enabled = true`, req.GetId())
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "load job: %v", err)
+	now := time.Now().UTC()
+	var fe fieldErrors
+	var override *jobs.Priority
+	if req.GetPriority() != "" {
+		p := jobs.Priority(req.GetPriority())
+		if !p.Valid() {
+			fe.add("priority", "must be high, normal or low")
+		}
+		override = &p
 	}
-	defer rows.Close()
-	if !rows.Next() {
+	at := parseTime(&fe, "run_at", req.GetRunAt())
+	switch {
+	case !at.IsZero() && req.GetDelaySeconds() != 0:
+		fe.add("run_at", "set only one of run_at, delay_seconds")
+	case req.GetDelaySeconds() < 0:
+		fe.add("delay_seconds", "must be >= 0")
+	case req.GetDelaySeconds() > 0:
+		at = now.Add(time.Duration(req.GetDelaySeconds()) * time.Second)
+	case !at.IsZero() && !at.After(now):
+		fe.add("run_at", "must be in the future")
+	}
+	if err := fe.err(); err != nil {
+		return nil, err
+	}
+
+	// Load job to get default args
+	j, err := s.Store.GetJob(ctx, req.GetId())
+	if errors.Is(err, jobs.ErrNotFound) || (err == nil && !j.Enabled) {
 		return nil, status.Error(codes.NotFound, "job not found or disabled")
 	}
-	var j jobs.Job
-	var argsRaw []byte
-	if err := rows.Scan(&j.ID, &j.Name, &j.Type, &j.Handler, &argsRaw, &j.Enabled, &j.CreatedAt, &j.UpdatedAt); err != nil {
-		return nil, status.Errorf(codes.Internal, "scan: %v", err)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load job: %v", err)
 	}
-	_ = json.Unmarshal(argsRaw, &j.Args)
 
-	override, err := parseJSONMap(req.GetArgsJson())
+	argsOverride, err := parseJSONMap(req.GetArgsJson())
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "args_json: %v", err)
 	}
 	// merge override onto default
-	if len(override) > 0 {
-		for k, v := range override {
+	if len(argsOverride) > 0 {
+		for k, v := range argsOverride {
 			j.Args[k] = v
 		}
 	}
 
 	runID := uuid.NewString()
-	now := time.Now().UTC()
 	idKey, err := jobs.ComputeIdempotencyKey(j.ID, now, j.Args)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "idempotency: %v", err)
 	}
 
-	// Insert queued run record
-	if _, err := s.Store.InsertRun(ctx, jobs.InsertRunParams{
+	if !at.IsZero() {
+		r, err := s.Store.InsertPendingRun(ctx, jobs.InsertPendingRunParams{
+			JobID: j.ID, RunID: runID, IdempotencyKey: idKey, Args: j.Args, Priority: override, ScheduledFor: at,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "insert run: %v", err)
+		}
+		sf := r.ScheduledFor.UTC().Format(time.RFC3339)
+		return &proto.RunJobResponse{RunId: runID, ScheduledFor: &sf}, nil
+	}
+
+	// Insert queued run record and its adhoc message in one transaction; the
+	// relay publishes the message once the row is committed.
+	payload := j.RunMessage(runID)
+	tx, err := s.DB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin: %v", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+	if _, err := s.Store.InsertRunTx(ctx, tx, jobs.InsertRunParams{
 		JobID: j.ID, RunID: runID, Status: jobs.StatusQueued, IdempotencyKey: idKey,
 	}); err != nil {
 		return nil, status.Errorf(codes.Internal, "insert run: %v", err)
 	}
-
-	// Enqueue to adhoc stream
-	payload := map[string]any{
-		"run_id":  runID,
-		"job_id":  j.ID,
-		"handler": j.Handler,
-		"args":    j.Args,
+	if override != nil {
+		j.Priority = *override
 	}
-	// NOTE: XAddJSON expects *redis.Client; we will call it from main.go using the real client.
-	// Here we just return run_id; the enqueue is done in main.go handler.
-	_ = payload
+	if _, err := outbox.Enqueue(ctx, tx, s.Streams.Route(s.Streams.Adhoc, j.Queue, string(j.Priority)), payload); err != nil {
+		return nil, status.Errorf(codes.Internal, "enqueue: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	s.Relay.Notify()
 
 	return &proto.RunJobResponse{RunId: runID}, nil
 }
 
+/******** Host rate limits ********/
+
+// SetHostRateLimit limits the http runs of every job whose url is on host.
+// Hosts are matched case-insensitively, without scheme or port.
+func (s *Server) SetHostRateLimit(ctx context.Context, req *proto.SetHostRateLimitRequest) (*proto.SetHostRateLimitResponse, error) {
+	var fe fieldErrors
+	host := jobs.NormalizeHost(req.GetHost())
+	if host == "" {
+		fe.add("host", "is required")
+	} else if strings.ContainsAny(host, ":/ ") {
+		fe.add("host", "must be a bare host name, without scheme, port or path")
+	}
+	l, err := fromProtoRateLimit(req.GetRateLimit(), false)
+	if err != nil {
+		fe.add("rate_limit", "%v", err)
+	} else if l == nil {
+		fe.add("rate_limit", "is required")
+	}
+	if err := fe.err(); err != nil {
+		return nil, err
+	}
+	h, err := s.Store.SetHostRateLimit(ctx, host, *l)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "set host rate limit: %v", err)
+	}
+	return &proto.SetHostRateLimitResponse{Limit: toProtoHostRateLimit(*h)}, nil
+}
+
+func (s *Server) ListHostRateLimits(ctx context.Context, _ *proto.ListHostRateLimitsRequest) (*proto.ListHostRateLimitsResponse, error) {
+	limits, err := s.Store.ListHostRateLimits(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list host rate limits: %v", err)
+	}
+	out := &proto.ListHostRateLimitsResponse{}
+	for _, h := range limits {
+		out.Limits = append(out.Limits, toProtoHostRateLimit(h))
+	}
+	return out, nil
+}
+
+func (s *Server) DeleteHostRateLimit(ctx context.Context, req *proto.DeleteHostRateLimitRequest) (*proto.DeleteHostRateLimitResponse, error) {
+	if err := s.Store.DeleteHostRateLimit(ctx, req.GetHost()); errors.Is(err, jobs.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "host rate limit not found")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "delete host rate limit: %v", err)
+	}
+	return &proto.DeleteHostRateLimitResponse{}, nil
+}
+
 /******** Schedules ********/
 
+// fieldErrors collects invalid request fields. err turns them into one
+// InvalidArgument status with a BadRequest detail listing each field.
+type fieldErrors []*errdetails.BadRequest_FieldViolation
+
+func (f *fieldErrors) add(field, format string, args ...any) {
+	*f = append(*f, &errdetails.BadRequest_FieldViolation{Field: field, Description: fmt.Sprintf(format, args...)})
+}
+
+func (f fieldErrors) err() error {
+	if len(f) == 0 {
+		return nil
+	}
+	msgs := make([]string, 0, len(f))
+	for _, v := range f {
+		msgs = append(msgs, v.Field+": "+v.Description)
+	}
+	st := status.New(codes.InvalidArgument, strings.Join(msgs, "; "))
+	if d, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: f}); err == nil {
+		st = d
+	}
+	return st.Err()
+}
+
+// notFound is a NotFound status naming the missing resource.
+func notFound(kind, id string) error {
+	st := status.Newf(codes.NotFound, "%s %s not found", kind, id)
+	if d, err := st.WithDetails(&errdetails.ResourceInfo{ResourceType: kind, ResourceName: id}); err == nil {
+		st = d
+	}
+	return st.Err()
+}
+
+// checkSpec validates the cron or interval of a schedule request. Set
+// fields must be usable; required asks for exactly one of them.
+func checkSpec(fe *fieldErrors, cron *string, interval *int32, required bool) {
+	hasCron := cron != nil && *cron != ""
+	switch {
+	case cron != nil && *cron == "":
+		// an empty expression would be stored and never fire
+		fe.add("cron_expr", "must not be empty")
+	case hasCron && interval != nil:
+		fe.add("cron_expr", "set only one of cron_expr, fixed_interval_seconds")
+	case required && !hasCron && interval == nil:
+		fe.add("cron_expr", "set one of cron_expr, fixed_interval_seconds")
+	case hasCron:
+		// rejects expressions the scheduler could not parse later
+		if _, err := schedule.ParseCron(*cron); err != nil {
+			fe.add("cron_expr", "%v", err)
+		}
+	case interval != nil && *interval <= 0:
+		fe.add("fixed_interval_seconds", "must be > 0")
+	}
+}
+
+func checkTimezone(fe *fieldErrors, tz *string) {
+	if tz == nil || *tz == "" {
+		return
+	}
+	if _, err := time.LoadLocation(*tz); err != nil {
+		fe.add("timezone", "unknown timezone %q", *tz)
+	}
+}
+
+func checkIntervalMode(fe *fieldErrors, mode *string) {
+	if mode != nil && !jobs.IntervalMode(*mode).Valid() {
+		fe.add("interval_mode", "unknown mode %q", *mode)
+	}
+}
+
+// misfireParams validates the misfire settings of a schedule request. nil
+// fields are left unset.
+func misfireParams(fe *fieldErrors, policy *string, grace, catchup *int32) (*jobs.MisfirePolicy, *int, *int) {
+	var p *jobs.MisfirePolicy
+	if policy != nil {
+		v := jobs.MisfirePolicy(*policy)
+		if !v.Valid() {
+			fe.add("misfire_policy", "unknown policy %q", *policy)
+		}
+		p = &v
+	}
+	if grace != nil && *grace < 0 {
+		fe.add("misfire_grace_seconds", "must be >= 0")
+	}
+	if catchup != nil && *catchup < 1 {
+		fe.add("max_catchup", "must be >= 1")
+	}
+	return p, toPtrInt(grace), toPtrInt(catchup)
+}
+
+// parseTime parses an RFC3339 field; "" is the zero time.
+func parseTime(fe *fieldErrors, field, v string) time.Time {
+	if v == "" {
+		return time.Time{}
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		fe.add(field, "want RFC3339: %v", err)
+	}
+	return t
+}
+
+// checkBounds validates a schedule's lifetime bounds; zero times are unset.
+func checkBounds(fe *fieldErrors, start, end time.Time, maxRuns *int32) {
+	if !start.IsZero() && !end.IsZero() && !end.After(start) {
+		fe.add("end_at", "must be after start_at")
+	}
+	if maxRuns != nil && *maxRuns < 1 {
+		fe.add("max_runs", "must be >= 1")
+	}
+}
+
+// parseNullTime parses an optional RFC3339 update field: nil leaves the
+// column alone and "" clears it.
+func parseNullTime(fe *fieldErrors, field string, v *string) *sql.NullTime {
+	if v == nil {
+		return nil
+	}
+	t := parseTime(fe, field, *v)
+	return &sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+func timePtr(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// CreateSchedule validates the whole request before touching the DB. When
+// next_run_at is omitted it is the first occurrence from now that the
+// bounds and calendars allow.
 func (s *Server) CreateSchedule(ctx context.Context, req *proto.CreateScheduleRequest) (*proto.CreateScheduleResponse, error) {
-	next, err := time.Parse(time.RFC3339, req.GetNextRunAt())
+	var fe fieldErrors
+	if (req.GetJobId() == "") == (req.GetWorkflowId() == "") {
+		fe.add("job_id", "set exactly one of job_id, workflow_id")
+	}
+	checkSpec(&fe, req.CronExpr, req.FixedIntervalSeconds, true)
+	tz := req.GetTimezone()
+	if tz == "" {
+		tz = "UTC"
+	}
+	checkTimezone(&fe, &tz)
+	next := parseTime(&fe, "next_run_at", req.GetNextRunAt())
+	var policy, mode *string
+	if req.GetMisfirePolicy() != "" {
+		policy = &req.MisfirePolicy
+	}
+	if req.GetIntervalMode() != "" {
+		mode = &req.IntervalMode
+	}
+	mp, grace, catchup := misfireParams(&fe, policy, req.MisfireGraceSeconds, req.MaxCatchup)
+	checkIntervalMode(&fe, mode)
+	start := parseTime(&fe, "start_at", req.GetStartAt())
+	end := parseTime(&fe, "end_at", req.GetEndAt())
+	paused := parseTime(&fe, "paused_until", req.GetPausedUntil())
+	checkBounds(&fe, start, end, req.MaxRuns)
+	if !end.IsZero() && !end.After(time.Now()) {
+		fe.add("end_at", "must be in the future")
+	}
+	if len(fe) == 0 {
+		// also catches crons that parse but never fire
+		first, err := schedule.NextRun(req.CronExpr, toPtrInt(req.FixedIntervalSeconds), time.Now(), tz)
+		if err != nil {
+			fe.add("cron_expr", "%v", err)
+		} else if next.IsZero() {
+			next = first
+		}
+	}
+	if err := fe.err(); err != nil {
+		return nil, err
+	}
+
+	// check the target here; the FK would only fail as a generic error
+	if req.GetJobId() != "" {
+		if _, err := s.Store.GetJob(ctx, req.GetJobId()); errors.Is(err, jobs.ErrNotFound) {
+			return nil, notFound("job", req.GetJobId())
+		} else if err != nil {
+			return nil, status.Errorf(codes.Internal, "get job: %v", err)
+		}
+	} else {
+		if _, err := s.Store.GetWorkflow(ctx, req.GetWorkflowId()); errors.Is(err, jobs.ErrNotFound) {
+			return nil, notFound("workflow", req.GetWorkflowId())
+		} else if err != nil {
+			return nil, status.Errorf(codes.Internal, "get workflow: %v", err)
+		}
+	}
+
+	cals, err := s.loadCalendars(ctx, req.GetCalendarIds())
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "next_run_at: %v", err)
+		return nil, err
 	}
-	sc, err := s.Store.CreateSchedule(ctx, jobs.CreateScheduleParams{
-		JobID: req.GetJobId(), CronExpr: req.CronExpr, FixedIntervalSeconds: toPtrInt(req.FixedIntervalSeconds),
-		NextRunAt: next, Timezone: req.GetTimezone(), Enabled: req.GetEnabled(),
-	})
+
+	p := jobs.CreateScheduleParams{
+		JobID: req.GetJobId(), WorkflowID: req.WorkflowId, CronExpr: req.CronExpr, FixedIntervalSeconds: toPtrInt(req.FixedIntervalSeconds),
+		NextRunAt: next, Timezone: tz, Enabled: req.GetEnabled(),
+		MisfirePolicy: jobs.DefaultMisfirePolicy, MisfireGraceSeconds: jobs.DefaultMisfireGraceSeconds, MaxCatchup: jobs.DefaultMaxCatchup,
+		IntervalMode: jobs.IntervalElapsed, CalendarIDs: req.GetCalendarIds(),
+		StartAt: timePtr(start), EndAt: timePtr(end), MaxRuns: toPtrInt(req.MaxRuns), PausedUntil: timePtr(paused),
+	}
+	if mp != nil {
+		p.MisfirePolicy = *mp
+	}
+	if grace != nil {
+		p.MisfireGraceSeconds = *grace
+	}
+	if catchup != nil {
+		p.MaxCatchup = *catchup
+	}
+	if mode != nil {
+		p.IntervalMode = jobs.IntervalMode(*mode)
+	}
+	if req.GetNextRunAt() == "" {
+		now := time.Now()
+		first, err := schedule.FirstRun(jobs.Schedule{
+			CronExpr: p.CronExpr, FixedIntervalSeconds: p.FixedIntervalSeconds, Timezone: tz,
+			IntervalMode: p.IntervalMode, IntervalAnchor: now, StartAt: p.StartAt, PausedUntil: p.PausedUntil,
+		}, cals, now)
+		if err != nil {
+			fe.add("calendar_ids", "%v", err)
+			return nil, fe.err()
+		}
+		p.NextRunAt = first
+	}
+	sc, err := s.Store.CreateSchedule(ctx, p)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "create schedule: %v", err)
 	}
@@ -208,17 +641,72 @@ func (s *Server) ListSchedules(ctx context.Context, req *proto.ListSchedulesRequ
 }
 
 func (s *Server) UpdateSchedule(ctx context.Context, req *proto.UpdateScheduleRequest) (*proto.UpdateScheduleResponse, error) {
+	var fe fieldErrors
+	checkSpec(&fe, req.CronExpr, req.FixedIntervalSeconds, false)
+	checkTimezone(&fe, req.Timezone)
 	var next *time.Time
 	if req.NextRunAt != nil {
-		t, err := time.Parse(time.RFC3339, req.GetNextRunAt())
-		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "next_run_at: %v", err)
-		}
+		t := parseTime(&fe, "next_run_at", req.GetNextRunAt())
 		next = &t
 	}
+	mp, grace, catchup := misfireParams(&fe, req.MisfirePolicy, req.MisfireGraceSeconds, req.MaxCatchup)
+	checkIntervalMode(&fe, req.IntervalMode)
+	start := parseNullTime(&fe, "start_at", req.StartAt)
+	end := parseNullTime(&fe, "end_at", req.EndAt)
+	paused := parseNullTime(&fe, "paused_until", req.PausedUntil)
+	var maxRuns *sql.NullInt32
+	if req.MaxRuns != nil {
+		if req.GetMaxRuns() < 0 {
+			fe.add("max_runs", "must be >= 0")
+		}
+		maxRuns = &sql.NullInt32{Int32: req.GetMaxRuns(), Valid: req.GetMaxRuns() > 0}
+	}
+	if err := fe.err(); err != nil {
+		return nil, err
+	}
+	if (start != nil && start.Valid) || (end != nil && end.Valid) {
+		// compare with the stored bound the request leaves alone
+		var s0, e0 time.Time
+		if start == nil || end == nil {
+			cur, err := s.Store.GetSchedule(ctx, req.GetId())
+			if errors.Is(err, jobs.ErrNotFound) {
+				return nil, notFound("schedule", req.GetId())
+			}
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "get schedule: %v", err)
+			}
+			if cur.StartAt != nil {
+				s0 = *cur.StartAt
+			}
+			if cur.EndAt != nil {
+				e0 = *cur.EndAt
+			}
+		}
+		if start != nil {
+			s0 = start.Time
+		}
+		if end != nil {
+			e0 = end.Time
+		}
+		checkBounds(&fe, s0, e0, nil)
+		if err := fe.err(); err != nil {
+			return nil, err
+		}
+	}
+	var calendarIDs *[]string
+	if req.Calendars != nil {
+		ids := req.GetCalendars().GetIds()
+		if _, err := s.loadCalendars(ctx, ids); err != nil {
+			return nil, err
+		}
+		calendarIDs = &ids
+	}
 	sc, err := s.Store.UpdateSchedule(ctx, jobs.UpdateScheduleParams{
 		ID: req.GetId(), CronExpr: req.CronExpr, FixedIntervalSeconds: toPtrInt(req.FixedIntervalSeconds),
 		NextRunAt: next, Timezone: req.Timezone, Enabled: req.Enabled,
+		MisfirePolicy: mp, MisfireGraceSeconds: grace, MaxCatchup: catchup,
+		IntervalMode: (*jobs.IntervalMode)(req.IntervalMode), CalendarIDs: calendarIDs,
+		StartAt: start, EndAt: end, MaxRuns: maxRuns, PausedUntil: paused,
 	})
 	if errors.Is(err, jobs.ErrNotFound) {
 		return nil, status.Error(codes.NotFound, "schedule not found")
@@ -238,6 +726,272 @@ func (s *Server) DeleteSchedule(ctx context.Context, req *proto.DeleteScheduleRe
 	return &proto.DeleteScheduleResponse{}, nil
 }
 
+// PreviewSchedule lists upcoming fire times without saving anything.
+func (s *Server) PreviewSchedule(ctx context.Context, req *proto.PreviewScheduleRequest) (*proto.PreviewScheduleResponse, error) {
+	var fe fieldErrors
+	checkSpec(&fe, req.CronExpr, req.FixedIntervalSeconds, req.GetId() == "")
+	checkTimezone(&fe, &req.Timezone)
+	if req.GetIntervalMode() != "" {
+		checkIntervalMode(&fe, &req.IntervalMode)
+	}
+	start := parseTime(&fe, "start_at", req.GetStartAt())
+	if err := fe.err(); err != nil {
+		return nil, err
+	}
+	if start.IsZero() {
+		start = time.Now()
+	}
+
+	// a new spec's wall_clock intervals count from start
+	sc := jobs.Schedule{IntervalMode: jobs.IntervalElapsed, IntervalAnchor: start, Timezone: "UTC"}
+	calendarIDs := req.GetCalendarIds()
+	if req.GetId() != "" {
+		got, err := s.Store.GetSchedule(ctx, req.GetId())
+		if errors.Is(err, jobs.ErrNotFound) {
+			return nil, notFound("schedule", req.GetId())
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "get schedule: %v", err)
+		}
+		sc = *got
+		calendarIDs = append(sc.CalendarIDs, calendarIDs...)
+	}
+	cals, err := s.loadCalendars(ctx, calendarIDs)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetCronExpr() != "" {
+		sc.CronExpr, sc.FixedIntervalSeconds = req.CronExpr, nil
+	}
+	if req.FixedIntervalSeconds != nil {
+		sc.CronExpr, sc.FixedIntervalSeconds = nil, toPtrInt(req.FixedIntervalSeconds)
+	}
+	if req.GetTimezone() != "" {
+		sc.Timezone = req.GetTimezone()
+	}
+	if req.GetIntervalMode() != "" {
+		sc.IntervalMode = jobs.IntervalMode(req.GetIntervalMode())
+	}
+
+	n := int(req.GetCount())
+	if n <= 0 {
+		n = 10
+	}
+	n = min(n, 100)
+	times, suppressed, warnings, err := schedule.Preview(sc, cals, start, n)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "preview: %v", err)
+	}
+	out := make([]*proto.ScheduleFireTime, 0, len(times))
+	for _, t := range times {
+		out = append(out, &proto.ScheduleFireTime{Utc: t.UTC().Format(time.RFC3339), Local: t.Format(time.RFC3339)})
+	}
+	sup := make([]*proto.ScheduleSuppression, 0, len(suppressed))
+	for _, su := range suppressed {
+		sup = append(sup, toProtoSuppression(jobs.ScheduleSuppression{
+			ScheduleID: sc.ID, CalendarID: su.CalendarID, Reason: su.Reason, SuppressedCount: su.Count,
+			FirstAt: su.First, LastAt: su.Last,
+		}))
+	}
+	return &proto.PreviewScheduleResponse{
+		FireTimes: out, Description: schedule.Describe(sc), Warnings: warnings, Suppressed: sup,
+	}, nil
+}
+
+// ListScheduleMisfires returns the schedule's recorded misfires, newest first.
+func (s *Server) ListScheduleMisfires(ctx context.Context, req *proto.ListScheduleMisfiresRequest) (*proto.ListScheduleMisfiresResponse, error) {
+	list, err := s.Store.ListMisfires(ctx, req.GetId(), int(req.GetLimit()))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list misfires: %v", err)
+	}
+	out := make([]*proto.ScheduleMisfire, 0, len(list))
+	for _, m := range list {
+		out = append(out, &proto.ScheduleMisfire{
+			Id: m.ID, ScheduleId: m.ScheduleID, Policy: string(m.Policy), MissedCount: int32(m.MissedCount),
+			FirstMissedAt: m.FirstMissedAt.UTC().Format(time.RFC3339),
+			LastMissedAt:  m.LastMissedAt.UTC().Format(time.RFC3339),
+			DetectedAt:    m.DetectedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return &proto.ListScheduleMisfiresResponse{Misfires: out}, nil
+}
+
+// ListScheduleSuppressions returns the occurrences the schedule's calendars
+// stopped, newest first.
+func (s *Server) ListScheduleSuppressions(ctx context.Context, req *proto.ListScheduleSuppressionsRequest) (*proto.ListScheduleSuppressionsResponse, error) {
+	list, err := s.Store.ListSuppressions(ctx, req.GetId(), int(req.GetLimit()))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list suppressions: %v", err)
+	}
+	out := make([]*proto.ScheduleSuppression, 0, len(list))
+	for _, su := range list {
+		out = append(out, toProtoSuppression(su))
+	}
+	return &proto.ListScheduleSuppressionsResponse{Suppressions: out}, nil
+}
+
+// loadCalendars fetches and compiles the calendars a schedule request names.
+func (s *Server) loadCalendars(ctx context.Context, ids []string) (*schedule.Calendars, error) {
+	cals := make([]jobs.Calendar, 0, len(ids))
+	for _, id := range ids {
+		c, err := s.Store.GetCalendar(ctx, id)
+		if errors.Is(err, jobs.ErrNotFound) {
+			return nil, notFound("calendar", id)
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "get calendar: %v", err)
+		}
+		cals = append(cals, *c)
+	}
+	compiled, err := schedule.CompileCalendars(cals)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "calendar: %v", err)
+	}
+	return compiled, nil
+}
+
+/******** Calendars ********/
+
+// calendarExclusions validates and converts a calendar request's dates and
+// windows.
+func calendarExclusions(fe *fieldErrors, tz string, dates []*proto.CalendarDate, windows []*proto.CalendarWindow) ([]jobs.CalendarDate, []jobs.CalendarWindow) {
+	outD := make([]jobs.CalendarDate, 0, len(dates))
+	for i, d := range dates {
+		day, err := time.Parse(time.DateOnly, d.GetDay())
+		if err != nil {
+			fe.add(fmt.Sprintf("dates[%d].day", i), "want YYYY-MM-DD, got %q", d.GetDay())
+			continue
+		}
+		outD = append(outD, jobs.CalendarDate{Day: day, Label: d.GetLabel()})
+	}
+	outW := make([]jobs.CalendarWindow, 0, len(windows))
+	for i, w := range windows {
+		cw := jobs.CalendarWindow{CronExpr: w.GetCronExpr(), DurationSeconds: int(w.GetDurationSeconds()), Label: w.GetLabel()}
+		if _, err := schedule.CompileCalendars([]jobs.Calendar{{Name: "window", Timezone: tz, Windows: []jobs.CalendarWindow{cw}}}); err != nil {
+			fe.add(fmt.Sprintf("windows[%d]", i), "%v", err)
+			continue
+		}
+		outW = append(outW, cw)
+	}
+	return outD, outW
+}
+
+func parseICS(fe *fieldErrors, tz, data string) []jobs.CalendarDate {
+	if data == "" {
+		return nil
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil // reported by checkTimezone
+	}
+	dates, err := schedule.ParseICS(data, loc)
+	if err != nil {
+		fe.add("ics", "%v", err)
+	}
+	return dates
+}
+
+func (s *Server) CreateCalendar(ctx context.Context, req *proto.CreateCalendarRequest) (*proto.CreateCalendarResponse, error) {
+	var fe fieldErrors
+	if req.GetName() == "" {
+		fe.add("name", "required")
+	}
+	tz := req.GetTimezone()
+	if tz == "" {
+		tz = "UTC"
+	}
+	checkTimezone(&fe, &tz)
+	dates, windows := calendarExclusions(&fe, tz, req.GetDates(), req.GetWindows())
+	dates = append(dates, parseICS(&fe, tz, req.GetIcs())...)
+	if err := fe.err(); err != nil {
+		return nil, err
+	}
+	c, err := s.Store.CreateCalendar(ctx, jobs.CreateCalendarParams{Name: req.GetName(), Timezone: tz, Dates: dates, Windows: windows})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "create calendar: %v", err)
+	}
+	return &proto.CreateCalendarResponse{Calendar: toProtoCalendar(*c)}, nil
+}
+
+func (s *Server) GetCalendar(ctx context.Context, req *proto.GetCalendarRequest) (*proto.GetCalendarResponse, error) {
+	c, err := s.Store.GetCalendar(ctx, req.GetId())
+	if errors.Is(err, jobs.ErrNotFound) {
+		return nil, notFound("calendar", req.GetId())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get calendar: %v", err)
+	}
+	return &proto.GetCalendarResponse{Calendar: toProtoCalendar(*c)}, nil
+}
+
+// ListCalendars returns calendars without their dates and windows.
+func (s *Server) ListCalendars(ctx context.Context, req *proto.ListCalendarsRequest) (*proto.ListCalendarsResponse, error) {
+	list, err := s.Store.ListCalendars(ctx, jobs.ListCalendarsParams{Limit: int(req.GetLimit()), Offset: int(req.GetOffset())})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list calendars: %v", err)
+	}
+	out := make([]*proto.Calendar, 0, len(list))
+	for _, c := range list {
+		out = append(out, toProtoCalendar(c))
+	}
+	return &proto.ListCalendarsResponse{Calendars: out}, nil
+}
+
+// UpdateCalendar replaces the dates or windows that are set; ics dates are
+// added to the calendar's dates.
+func (s *Server) UpdateCalendar(ctx context.Context, req *proto.UpdateCalendarRequest) (*proto.UpdateCalendarResponse, error) {
+	var fe fieldErrors
+	if req.Name != nil && req.GetName() == "" {
+		fe.add("name", "must not be empty")
+	}
+	checkTimezone(&fe, req.Timezone)
+	// windows are checked against the new timezone, or the stored one
+	tz := req.GetTimezone()
+	if tz == "" && req.Windows != nil {
+		c, err := s.Store.GetCalendar(ctx, req.GetId())
+		if errors.Is(err, jobs.ErrNotFound) {
+			return nil, notFound("calendar", req.GetId())
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "get calendar: %v", err)
+		}
+		tz = c.Timezone
+	}
+	p := jobs.UpdateCalendarParams{ID: req.GetId(), Name: req.Name, Timezone: req.Timezone}
+	dates, windows := calendarExclusions(&fe, tz, req.GetDates().GetDates(), req.GetWindows().GetWindows())
+	if req.Dates != nil {
+		p.Dates = &dates
+	}
+	if req.Windows != nil {
+		p.Windows = &windows
+	}
+	p.AddDates = parseICS(&fe, tz, req.GetIcs())
+	if err := fe.err(); err != nil {
+		return nil, err
+	}
+	c, err := s.Store.UpdateCalendar(ctx, p)
+	if errors.Is(err, jobs.ErrNotFound) {
+		return nil, notFound("calendar", req.GetId())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "update calendar: %v", err)
+	}
+	return &proto.UpdateCalendarResponse{Calendar: toProtoCalendar(*c)}, nil
+}
+
+func (s *Server) DeleteCalendar(ctx context.Context, req *proto.DeleteCalendarRequest) (*proto.DeleteCalendarResponse, error) {
+	err := s.Store.DeleteCalendar(ctx, req.GetId())
+	switch {
+	case errors.Is(err, jobs.ErrNotFound):
+		return nil, notFound("calendar", req.GetId())
+	case errors.Is(err, jobs.ErrCalendarInUse):
+		return nil, status.Errorf(codes.FailedPrecondition, "delete calendar: %v", err)
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "delete calendar: %v", err)
+	}
+	return &proto.DeleteCalendarResponse{}, nil
+}
+
 /******** Runs ********/
 
 func (s *Server) ListJobRuns(ctx context.Context, req *proto.ListJobRunsRequest) (*proto.ListJobRunsResponse, error) {
@@ -252,6 +1006,186 @@ func (s *Server) ListJobRuns(ctx context.Context, req *proto.ListJobRunsRequest)
 	return &proto.ListJobRunsResponse{Runs: out}, nil
 }
 
+// ListPendingRuns lists delayed runs that are not due yet, soonest first.
+func (s *Server) ListPendingRuns(ctx context.Context, req *proto.ListPendingRunsRequest) (*proto.ListPendingRunsResponse, error) {
+	runs, err := s.Store.ListPendingRuns(ctx, req.GetJobId(), int(req.GetLimit()))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list pending runs: %v", err)
+	}
+	out := make([]*proto.JobRun, 0, len(runs))
+	for _, r := range runs {
+		out = append(out, toProtoRun(r))
+	}
+	return &proto.ListPendingRunsResponse{Runs: out}, nil
+}
+
+func (s *Server) GetRunOutput(ctx context.Context, req *proto.GetRunOutputRequest) (*proto.GetRunOutputResponse, error) {
+	if req.GetRunId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "run_id required")
+	}
+	outputs, err := s.Store.ListRunOutputs(ctx, req.GetRunId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get output: %v", err)
+	}
+	if len(outputs) == 0 {
+		// distinguish "no such run" from "run has not produced output yet"
+		if _, err := s.Store.GetRun(ctx, req.GetRunId()); errors.Is(err, jobs.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "run not found")
+		} else if err != nil {
+			return nil, status.Errorf(codes.Internal, "get run: %v", err)
+		}
+	}
+	out := make([]*proto.RunOutput, 0, len(outputs))
+	for _, o := range outputs {
+		if req.Attempt != nil && int32(o.Attempt) != req.GetAttempt() {
+			continue
+		}
+		out = append(out, toProtoOutput(o))
+	}
+	return &proto.GetRunOutputResponse{RunId: req.GetRunId(), Outputs: out}, nil
+}
+
+// CancelRun stops a run. Queued runs are skipped when a worker picks them
+// up; a running one is signalled on its worker's cancel channel.
+func (s *Server) CancelRun(ctx context.Context, req *proto.CancelRunRequest) (*proto.CancelRunResponse, error) {
+	if req.GetRunId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "run_id required")
+	}
+	r, err := s.Store.CancelRun(ctx, req.GetRunId())
+	if errors.Is(err, jobs.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "run not found")
+	}
+	if errors.Is(err, jobs.ErrRunFinished) {
+		return nil, status.Errorf(codes.FailedPrecondition, "run already %s", r.Status)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cancel: %v", err)
+	}
+	s.signalCancel(ctx, *r)
+	return &proto.CancelRunResponse{Run: toProtoRun(*r)}, nil
+}
+
+// signalCancel tells the worker executing r to stop. The DB row is already
+// canceled, so a lost signal only means the handler runs to completion.
+func (s *Server) signalCancel(ctx context.Context, r jobs.JobRun) {
+	if r.WorkerID == nil || *r.WorkerID == "" {
+		return
+	}
+	if _, err := redisx.PublishCancel(ctx, s.RDB, s.Streams.CancelPrefix, *r.WorkerID, r.RunID); err != nil {
+		log.Printf("cancel signal run=%s worker=%s: %v", r.RunID, *r.WorkerID, err)
+	}
+}
+
+/******** Workflows ********/
+
+// checkGraph validates the workflow graph and that every node's job exists.
+func (s *Server) checkGraph(ctx context.Context, w jobs.Workflow) error {
+	if err := w.Validate(); err != nil {
+		return status.Errorf(codes.InvalidArgument, "workflow: %v", err)
+	}
+	for _, n := range w.Nodes {
+		if _, err := s.Store.GetJob(ctx, n.JobID); errors.Is(err, jobs.ErrNotFound) {
+			return status.Errorf(codes.InvalidArgument, "workflow: job %s not found", n.JobID)
+		} else if err != nil {
+			return status.Errorf(codes.Internal, "get job: %v", err)
+		}
+	}
+	return nil
+}
+
+func (s *Server) CreateWorkflow(ctx context.Context, req *proto.CreateWorkflowRequest) (*proto.CreateWorkflowResponse, error) {
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name required")
+	}
+	w := fromProtoGraph(req.GetNodes(), req.GetEdges())
+	if err := s.checkGraph(ctx, w); err != nil {
+		return nil, err
+	}
+	wf, err := s.Store.CreateWorkflow(ctx, jobs.CreateWorkflowParams{
+		Name: req.GetName(), Enabled: req.GetEnabled(), Nodes: w.Nodes, Edges: w.Edges,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "create workflow: %v", err)
+	}
+	return &proto.CreateWorkflowResponse{Workflow: toProtoWorkflow(*wf)}, nil
+}
+
+func (s *Server) GetWorkflow(ctx context.Context, req *proto.GetWorkflowRequest) (*proto.GetWorkflowResponse, error) {
+	wf, err := s.Store.GetWorkflow(ctx, req.GetId())
+	if errors.Is(err, jobs.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "workflow not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get workflow: %v", err)
+	}
+	return &proto.GetWorkflowResponse{Workflow: toProtoWorkflow(*wf)}, nil
+}
+
+func (s *Server) ListWorkflows(ctx context.Context, req *proto.ListWorkflowsRequest) (*proto.ListWorkflowsResponse, error) {
+	list, err := s.Store.ListWorkflows(ctx, jobs.ListWorkflowsParams{Limit: int(req.GetLimit()), Offset: int(req.GetOffset())})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list workflows: %v", err)
+	}
+	out := make([]*proto.Workflow, 0, len(list))
+	for _, wf := range list {
+		out = append(out, toProtoWorkflow(wf))
+	}
+	return &proto.ListWorkflowsResponse{Workflows: out}, nil
+}
+
+func (s *Server) UpdateWorkflow(ctx context.Context, req *proto.UpdateWorkflowRequest) (*proto.UpdateWorkflowResponse, error) {
+	p := jobs.UpdateWorkflowParams{ID: req.GetId(), Name: req.Name, Enabled: req.Enabled}
+	if len(req.GetNodes()) > 0 {
+		w := fromProtoGraph(req.GetNodes(), req.GetEdges())
+		if err := s.checkGraph(ctx, w); err != nil {
+			return nil, err
+		}
+		p.Nodes, p.Edges = w.Nodes, w.Edges
+	} else if len(req.GetEdges()) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "edges can only be replaced together with nodes")
+	}
+	wf, err := s.Store.UpdateWorkflow(ctx, p)
+	if errors.Is(err, jobs.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "workflow not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "update workflow: %v", err)
+	}
+	return &proto.UpdateWorkflowResponse{Workflow: toProtoWorkflow(*wf)}, nil
+}
+
+func (s *Server) DeleteWorkflow(ctx context.Context, req *proto.DeleteWorkflowRequest) (*proto.DeleteWorkflowResponse, error) {
+	if err := s.Store.DeleteWorkflow(ctx, req.GetId()); errors.Is(err, jobs.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "workflow not found")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "delete workflow: %v", err)
+	}
+	return &proto.DeleteWorkflowResponse{}, nil
+}
+
+// RunWorkflow starts a workflow run now; its root jobs are enqueued at once.
+func (s *Server) RunWorkflow(ctx context.Context, req *proto.RunWorkflowRequest) (*proto.RunWorkflowResponse, error) {
+	run, err := s.Workflows.Start(ctx, req.GetId())
+	if errors.Is(err, jobs.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "workflow not found or disabled")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "run workflow: %v", err)
+	}
+	return &proto.RunWorkflowResponse{Run: toProtoWorkflowRun(*run)}, nil
+}
+
+func (s *Server) GetWorkflowRun(ctx context.Context, req *proto.GetWorkflowRunRequest) (*proto.GetWorkflowRunResponse, error) {
+	run, err := s.Store.GetWorkflowRun(ctx, req.GetId())
+	if errors.Is(err, jobs.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "workflow run not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get workflow run: %v", err)
+	}
+	return &proto.GetWorkflowRunResponse{Run: toProtoWorkflowRun(*run)}, nil
+}
+
 /******** Converters ********/
 
 func toProtoJob(j jobs.Job) *proto.Job {
@@ -260,8 +1194,64 @@ func toProtoJob(j jobs.Job) *proto.Job {
 		Id: j.ID, Name: j.Name, Type: j.Type, Handler: j.Handler,
 		ArgsJson: string(argsB), Enabled: j.Enabled,
 		CreatedAt: j.CreatedAt.Format(time.RFC3339), UpdatedAt: j.UpdatedAt.Format(time.RFC3339),
+		RetryPolicy: toProtoRetry(j.RetryPolicy), MaxConcurrency: int32(j.MaxConcurrency),
+		Priority: string(j.Priority), Queue: j.Queue, RateLimit: toProtoRateLimit(j.RateLimit),
+	}
+}
+func toProtoRetry(p *jobs.RetryPolicy) *proto.RetryPolicy {
+	if p == nil {
+		return nil
+	}
+	return &proto.RetryPolicy{
+		MaxAttempts: int32(p.MaxAttempts), Strategy: string(p.Strategy),
+		BaseDelayMs: p.BaseDelayMS, MaxDelayMs: p.MaxDelayMS, Jitter: string(p.Jitter),
+	}
+}
+
+// fromProtoRetry fills unset fields from the default policy and validates.
+func fromProtoRetry(p *proto.RetryPolicy) (*jobs.RetryPolicy, error) {
+	if p == nil {
+		return nil, nil
+	}
+	rp := jobs.RetryPolicy{
+		MaxAttempts: int(p.GetMaxAttempts()), Strategy: jobs.BackoffStrategy(p.GetStrategy()),
+		BaseDelayMS: p.GetBaseDelayMs(), MaxDelayMS: p.MaxDelayMs, Jitter: jobs.JitterMode(p.GetJitter()),
+	}.WithDefaults(jobs.DefaultRetryPolicy())
+	if err := rp.Validate(); err != nil {
+		return nil, err
 	}
+	return &rp, nil
 }
+
+func toProtoRateLimit(l *jobs.RateLimit) *proto.RateLimit {
+	if l == nil {
+		return nil
+	}
+	return &proto.RateLimit{Limit: int32(l.Limit), PeriodMs: l.PeriodMS, Burst: int32(l.Burst)}
+}
+
+func toProtoHostRateLimit(h jobs.HostRateLimit) *proto.HostRateLimit {
+	return &proto.HostRateLimit{
+		Host: h.Host, RateLimit: toProtoRateLimit(&h.RateLimit), UpdatedAt: h.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// fromProtoRateLimit converts and validates a rate limit. A zero limit is
+// returned as is when clear is set (UpdateJob removing the limit).
+func fromProtoRateLimit(p *proto.RateLimit, clear bool) (*jobs.RateLimit, error) {
+	if p == nil {
+		return nil, nil
+	}
+	l := jobs.RateLimit{Limit: int(p.GetLimit()), PeriodMS: p.GetPeriodMs(), Burst: int(p.GetBurst())}
+	if clear && l.Limit == 0 {
+		return &l, nil
+	}
+	if err := l.Validate(); err != nil {
+		return nil, err
+	}
+	return &l, nil
+}
+
 func toProtoSchedule(sc jobs.Schedule) *proto.Schedule {
 	var last *string
 	if sc.LastEnqueuedAt != nil {
@@ -272,9 +1262,96 @@ func toProtoSchedule(sc jobs.Schedule) *proto.Schedule {
 		Id: sc.ID, JobId: sc.JobID, CronExpr: sc.CronExpr,
 		FixedIntervalSeconds: toPtr32(sc.FixedIntervalSeconds),
 		NextRunAt:            sc.NextRunAt.UTC().Format(time.RFC3339), Timezone: sc.Timezone,
-		LastEnqueuedAt: last, Enabled: sc.Enabled,
+		LastEnqueuedAt: last, Enabled: sc.Enabled, WorkflowId: sc.WorkflowID,
+		MisfirePolicy: string(sc.MisfirePolicy), MisfireGraceSeconds: int32(sc.MisfireGraceSeconds), MaxCatchup: int32(sc.MaxCatchup),
+		IntervalMode: string(sc.IntervalMode), CalendarIds: sc.CalendarIDs,
+		StartAt: formatPtr(sc.StartAt), EndAt: formatPtr(sc.EndAt), MaxRuns: toPtr32(sc.MaxRuns),
+		PausedUntil: formatPtr(sc.PausedUntil), FireCount: sc.FireCount,
 	}
 }
+
+// formatPtr formats an optional time as RFC3339 in UTC.
+func formatPtr(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	v := t.UTC().Format(time.RFC3339)
+	return &v
+}
+
+func toProtoSuppression(su jobs.ScheduleSuppression) *proto.ScheduleSuppression {
+	out := &proto.ScheduleSuppression{
+		Id: su.ID, ScheduleId: su.ScheduleID, CalendarId: su.CalendarID, Reason: su.Reason,
+		SuppressedCount: int32(su.SuppressedCount),
+		FirstAt:         su.FirstAt.UTC().Format(time.RFC3339), LastAt: su.LastAt.UTC().Format(time.RFC3339),
+	}
+	if !su.DetectedAt.IsZero() {
+		out.DetectedAt = su.DetectedAt.UTC().Format(time.RFC3339)
+	}
+	return out
+}
+
+func toProtoCalendar(c jobs.Calendar) *proto.Calendar {
+	out := &proto.Calendar{
+		Id: c.ID, Name: c.Name, Timezone: c.Timezone,
+		CreatedAt: c.CreatedAt.Format(time.RFC3339), UpdatedAt: c.UpdatedAt.Format(time.RFC3339),
+	}
+	for _, d := range c.Dates {
+		out.Dates = append(out.Dates, &proto.CalendarDate{Day: d.Day.Format(time.DateOnly), Label: d.Label})
+	}
+	for _, w := range c.Windows {
+		out.Windows = append(out.Windows, &proto.CalendarWindow{
+			CronExpr: w.CronExpr, DurationSeconds: int32(w.DurationSeconds), Label: w.Label,
+		})
+	}
+	return out
+}
+
+// fromProtoGraph converts nodes and edges; an empty trigger rule means all_success.
+func fromProtoGraph(nodes []*proto.WorkflowNode, edges []*proto.WorkflowEdge) jobs.Workflow {
+	var w jobs.Workflow
+	for _, n := range nodes {
+		rule := jobs.TriggerRule(n.GetTriggerRule())
+		if rule == "" {
+			rule = jobs.TriggerAllSuccess
+		}
+		w.Nodes = append(w.Nodes, jobs.WorkflowNode{JobID: n.GetJobId(), TriggerRule: rule})
+	}
+	for _, e := range edges {
+		w.Edges = append(w.Edges, jobs.WorkflowEdge{From: e.GetFromJobId(), To: e.GetToJobId()})
+	}
+	return w
+}
+
+func toProtoWorkflow(w jobs.Workflow) *proto.Workflow {
+	out := &proto.Workflow{
+		Id: w.ID, Name: w.Name, Enabled: w.Enabled,
+		CreatedAt: w.CreatedAt.Format(time.RFC3339), UpdatedAt: w.UpdatedAt.Format(time.RFC3339),
+	}
+	for _, n := range w.Nodes {
+		out.Nodes = append(out.Nodes, &proto.WorkflowNode{JobId: n.JobID, TriggerRule: string(n.TriggerRule)})
+	}
+	for _, e := range w.Edges {
+		out.Edges = append(out.Edges, &proto.WorkflowEdge{FromJobId: e.From, ToJobId: e.To})
+	}
+	return out
+}
+
+func toProtoWorkflowRun(r jobs.WorkflowRun) *proto.WorkflowRun {
+	var fin *string
+	if r.FinishedAt != nil {
+		v := r.FinishedAt.UTC().Format(time.RFC3339)
+		fin = &v
+	}
+	out := &proto.WorkflowRun{
+		Id: r.ID, WorkflowId: r.WorkflowID, Status: string(r.Status),
+		StartedAt: r.StartedAt.UTC().Format(time.RFC3339), FinishedAt: fin,
+	}
+	for _, n := range r.Nodes {
+		out.Nodes = append(out.Nodes, &proto.WorkflowRunNode{JobId: n.JobID, RunId: n.RunID, Status: string(n.Status)})
+	}
+	return out
+}
 func toProtoRun(r jobs.JobRun) *proto.JobRun {
 	var fin *string
 	if r.FinishedAt != nil {
@@ -289,11 +1366,29 @@ func toProtoRun(r jobs.JobRun) *proto.JobRun {
 	if r.WorkerID != nil {
 		worker = r.WorkerID
 	}
+	var scheduledFor *string
+	if r.ScheduledFor != nil {
+		v := r.ScheduledFor.UTC().Format(time.RFC3339)
+		scheduledFor = &v
+	}
 	return &proto.JobRun{
 		Id: r.ID, JobId: r.JobID, RunId: r.RunID,
 		StartedAt: r.StartedAt.UTC().Format(time.RFC3339), FinishedAt: fin,
 		Status: string(r.Status), Attempts: int32(r.Attempts),
 		ErrorText: errText, WorkerId: worker, IdempotencyKey: r.IdempotencyKey,
+		ErrorClass: r.ErrorClass, ScheduledFor: scheduledFor,
+		ThrottleCount: int32(r.ThrottleCount), ThrottledAt: formatPtr(r.ThrottledAt), ThrottledBy: r.ThrottledBy,
+	}
+}
+
+func toProtoOutput(o jobs.RunOutput) *proto.RunOutput {
+	// proto3 strings must be valid UTF-8; command output may not be
+	return &proto.RunOutput{
+		Attempt: int32(o.Attempt), ExitCode: toPtr32(o.ExitCode), HttpStatus: toPtr32(o.HTTPStatus),
+		Stdout: strings.ToValidUTF8(o.Stdout, "\uFFFD"), Stderr: strings.ToValidUTF8(o.Stderr, "\uFFFD"),
+		StdoutTruncated: o.StdoutTruncated, StderrTruncated: o.StderrTruncated,
+		StdoutBytes: int64(o.StdoutBytes), StderrBytes: int64(o.StderrBytes),
+		ErrorText: o.ErrorText, CreatedAt: o.CreatedAt.UTC().Format(time.RFC3339),
 	}
 }
 
@@ -0,0 +1,762 @@
+package server
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/rishansujesh/job-scheduler/internal/api/proto"
+	"github.com/rishansujesh/job-scheduler/internal/jobs"
+	redisx "github.com/rishansujesh/job-scheduler/internal/redis"
+	"github.com/rishansujesh/job-scheduler/internal/worker"
+)
+
+var (
+	jobCols = []string{"id", "name", "type", "handler", "args", "enabled", "retry_policy", "max_concurrency", "priority", "queue", "rate_limit", "created_at", "updated_at"}
+	runCols = []string{"id", "job_id", "run_id", "started_at", "finished_at", "status", "attempts", "error_text", "error_class", "worker_id", "idempotency_key", "scheduled_for", "throttle_count", "throttled_at", "throttled_by"}
+)
+
+const testJobID = "11111111-1111-1111-1111-111111111111"
+
+func testStreams() redisx.StreamsConfig {
+	return redisx.StreamsConfig{
+		Scheduled:     "jobs:scheduled",
+		Adhoc:         "jobs:adhoc",
+		High:          "jobs:high",
+		Low:           "jobs:low",
+		Retry:         "jobs:retry",
+		RetryDelayed:  "jobs:retry:delayed",
+		DLQ:           "jobs:dlq",
+		ConsumerGroup: "cg:workers",
+		SlotPrefix:    "jobs:slots:",
+		CancelPrefix:  "jobs:cancel:",
+		Queues:        []string{"gpu"},
+	}
+}
+
+func runRow(status jobs.JobRunStatus) *sqlmock.Rows {
+	now := time.Now().UTC()
+	return sqlmock.NewRows(runCols).
+		AddRow(1, testJobID, "run", now, nil, string(status), 0, nil, nil, nil, "key", nil, 0, nil, nil)
+}
+
+// captureArg matches any value and remembers it.
+type captureArg struct{ v driver.Value }
+
+func (c *captureArg) Match(v driver.Value) bool {
+	c.v = v
+	return true
+}
+
+// passthrough lets slice arguments (id = ANY($1)) reach sqlmock the way the
+// pgx driver accepts them.
+type passthrough struct{}
+
+func (passthrough) ConvertValue(v any) (driver.Value, error) {
+	if dv, err := driver.DefaultParameterConverter.ConvertValue(v); err == nil {
+		return dv, nil
+	}
+	return v, nil
+}
+
+func expectJob(mock sqlmock.Sqlmock) {
+	now := time.Now().UTC()
+	mock.ExpectQuery(`FROM jobs WHERE id = \$1`).
+		WithArgs(testJobID).
+		WillReturnRows(sqlmock.NewRows(jobCols).
+			AddRow(testJobID, "echo", "adhoc", "shell", []byte(`{"command":"echo hi"}`), true, nil, 0, "normal", "default", nil, now, now))
+}
+
+func TestRunJob_EnqueuesAndWorkerCompletes(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer rdb.Close()
+
+	db, mock, err := sqlmock.New(sqlmock.ValueConverterOption(passthrough{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	mock.MatchExpectationsInOrder(true)
+
+	// RunJob: run row and outbox message in one tx
+	payload := &captureArg{}
+	expectJob(mock)
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO job_runs`).WillReturnRows(runRow(jobs.StatusQueued))
+	mock.ExpectQuery(`INSERT INTO outbox`).WithArgs("jobs:adhoc", payload).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectCommit()
+
+	streams := testStreams()
+	s := New(db, rdb, streams)
+	s.Relay.Logger = log.New(io.Discard, "", 0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	resp, err := s.RunJob(ctx, &proto.RunJobRequest{Id: testJobID})
+	if err != nil {
+		t.Fatalf("RunJob: %v", err)
+	}
+	if resp.GetRunId() == "" {
+		t.Fatalf("expected run id")
+	}
+	if n, _ := rdb.XLen(ctx, streams.Adhoc).Result(); n != 0 {
+		t.Fatalf("message published before relay ran")
+	}
+
+	// relay publishes the committed row
+	mock.ExpectBegin()
+	mock.ExpectQuery(`FROM outbox`).WillReturnRows(sqlmock.NewRows([]string{"id", "stream", "payload"}).
+		AddRow(7, streams.Adhoc, []byte(payload.v.(string))))
+	mock.ExpectExec(`UPDATE outbox SET sent_at`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	// worker: queued -> running -> success
+	mock.ExpectQuery(`FROM job_runs\s+WHERE run_id = \$1`).WillReturnRows(runRow(jobs.StatusQueued))
+	mock.ExpectQuery(`UPDATE job_runs`).WillReturnRows(runRow(jobs.StatusRunning))
+	mock.ExpectExec(`INSERT INTO run_outputs`).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(`UPDATE job_runs`).WillReturnRows(runRow(jobs.StatusSuccess))
+
+	r := &worker.Runner{
+		DB:           db,
+		Store:        jobs.NewStore(db),
+		RDB:          rdb,
+		Streams:      streams,
+		Group:        streams.ConsumerGroup,
+		ConsumerName: "test-worker",
+		Retry:        jobs.DefaultRetryPolicy(),
+		Logger:       log.New(io.Discard, "", 0),
+	}
+	r.Start(ctx)
+	if n, err := s.Relay.PublishOnce(ctx); err != nil || n != 1 {
+		t.Fatalf("relay: n=%d err=%v", n, err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if err := mock.ExpectationsWereMet(); err == nil {
+			break
+		} else if time.Now().After(deadline) {
+			t.Fatalf("run did not complete: %v", err)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	pending, err := rdb.XPending(ctx, streams.Adhoc, streams.ConsumerGroup).Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pending.Count != 0 {
+		t.Fatalf("expected message acked, %d pending", pending.Count)
+	}
+}
+
+func TestRunJob_OutboxFailureRollsBackRun(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer rdb.Close()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	expectJob(mock)
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO job_runs`).WillReturnRows(runRow(jobs.StatusQueued))
+	mock.ExpectQuery(`INSERT INTO outbox`).WillReturnError(errors.New("boom"))
+	mock.ExpectRollback()
+
+	s := New(db, rdb, testStreams())
+	if _, err := s.RunJob(context.Background(), &proto.RunJobRequest{Id: testJobID}); err == nil {
+		t.Fatalf("expected error when outbox insert fails")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("expectations: %v", err)
+	}
+}
+
+func TestGetRunOutput_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM run_outputs`).WillReturnRows(sqlmock.NewRows(nil))
+	mock.ExpectQuery(`FROM job_runs\s+WHERE run_id = \$1`).WillReturnRows(sqlmock.NewRows(runCols))
+
+	s := New(db, nil, testStreams())
+	_, err = s.GetRunOutput(context.Background(), &proto.GetRunOutputRequest{RunId: "missing"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("want NotFound, got %v", err)
+	}
+}
+
+func TestCreateJob_ValidatesAgainstHandlerRegistry(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	s := New(db, nil, testStreams())
+
+	for _, req := range []*proto.CreateJobRequest{
+		{Name: "j", Type: "adhoc", Handler: "ftp", ArgsJson: `{}`},
+		{Name: "j", Type: "adhoc", Handler: "shell", ArgsJson: `{}`},
+		{Name: "j", Type: "adhoc", Handler: "http", ArgsJson: `{"url":42}`},
+		{Name: "j", Type: "adhoc", Handler: "shell", ArgsJson: `{"command":"true"}`, Queue: "db"},
+		{Name: "j", Type: "adhoc", Handler: "shell", ArgsJson: `{"command":"true"}`, RateLimit: &proto.RateLimit{Limit: 10}},
+	} {
+		if _, err := s.CreateJob(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s %s: want InvalidArgument, got %v", req.Handler, req.ArgsJson, err)
+		}
+	}
+	// nothing reached the database
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCancelRun_SignalsWorker(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer rdb.Close()
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	sub := rdb.Subscribe(context.Background(), "jobs:cancel:w1")
+	defer sub.Close()
+	if _, err := sub.Receive(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	mock.ExpectQuery(`UPDATE job_runs\s+SET status = 'canceled'`).WithArgs("run").
+		WillReturnRows(sqlmock.NewRows(runCols).
+			AddRow(1, testJobID, "run", time.Now(), time.Now(), "canceled", 1, nil, nil, "w1", "key", nil, 0, nil, nil))
+
+	s := New(db, rdb, testStreams())
+	resp, err := s.CancelRun(context.Background(), &proto.CancelRunRequest{RunId: "run"})
+	if err != nil || resp.GetRun().GetStatus() != "canceled" {
+		t.Fatalf("cancel: %v %v", resp, err)
+	}
+	select {
+	case msg := <-sub.Channel():
+		if msg.Payload != "run" {
+			t.Fatalf("signal payload = %q", msg.Payload)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatalf("worker was not signalled")
+	}
+}
+
+func TestCancelRun_AlreadyFinished(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery(`UPDATE job_runs\s+SET status = 'canceled'`).WillReturnRows(sqlmock.NewRows(runCols))
+	mock.ExpectQuery(`FROM job_runs\s+WHERE run_id = \$1`).WillReturnRows(runRow(jobs.StatusSuccess))
+
+	s := New(db, nil, testStreams())
+	_, err = s.CancelRun(context.Background(), &proto.CancelRunRequest{RunId: "run"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("want FailedPrecondition, got %v", err)
+	}
+}
+
+func TestCreateWorkflow_RejectsInvalidGraphs(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	s := New(db, nil, testStreams())
+
+	for name, req := range map[string]*proto.CreateWorkflowRequest{
+		"no name": {Nodes: []*proto.WorkflowNode{{JobId: "a"}}},
+		"cycle": {Name: "w",
+			Nodes: []*proto.WorkflowNode{{JobId: "a"}, {JobId: "b"}},
+			Edges: []*proto.WorkflowEdge{{FromJobId: "a", ToJobId: "b"}, {FromJobId: "b", ToJobId: "a"}}},
+		"bad rule": {Name: "w", Nodes: []*proto.WorkflowNode{{JobId: "a", TriggerRule: "whenever"}}},
+	} {
+		if _, err := s.CreateWorkflow(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: want InvalidArgument, got %v", name, err)
+		}
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCreateSchedule_NeedsExactlyOneTarget(t *testing.T) {
+	s := New(nil, nil, testStreams())
+	wf := "wf"
+	for _, req := range []*proto.CreateScheduleRequest{
+		{NextRunAt: "2030-01-01T00:00:00Z"},
+		{JobId: testJobID, WorkflowId: &wf, NextRunAt: "2030-01-01T00:00:00Z"},
+	} {
+		if _, err := s.CreateSchedule(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("want InvalidArgument, got %v", err)
+		}
+	}
+}
+
+func TestSchedules_RejectInvalidCron(t *testing.T) {
+	s := New(nil, nil, testStreams())
+	bad := "0 9 * * MON#9"
+	if _, err := s.CreateSchedule(context.Background(), &proto.CreateScheduleRequest{
+		JobId: testJobID, CronExpr: &bad, NextRunAt: "2030-01-01T00:00:00Z",
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("create: want InvalidArgument, got %v", err)
+	}
+	if _, err := s.UpdateSchedule(context.Background(), &proto.UpdateScheduleRequest{
+		Id: "s", CronExpr: &bad,
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("update: want InvalidArgument, got %v", err)
+	}
+}
+
+func TestSchedules_RejectInvalidMisfireSettings(t *testing.T) {
+	s := New(nil, nil, testStreams())
+	neg, zero, interval := int32(-1), int32(0), int32(60)
+	for _, tc := range []struct {
+		req   *proto.CreateScheduleRequest
+		field string
+	}{
+		{&proto.CreateScheduleRequest{MisfirePolicy: "fire_twice"}, "misfire_policy"},
+		{&proto.CreateScheduleRequest{MisfireGraceSeconds: &neg}, "misfire_grace_seconds"},
+		{&proto.CreateScheduleRequest{MaxCatchup: &zero}, "max_catchup"},
+	} {
+		// otherwise valid, so only the misfire setting is reported
+		tc.req.JobId, tc.req.NextRunAt, tc.req.FixedIntervalSeconds = testJobID, "2030-01-01T00:00:00Z", &interval
+		_, err := s.CreateSchedule(context.Background(), tc.req)
+		if got := violations(t, err); strings.Join(got, ",") != tc.field {
+			t.Errorf("create: violations %v, want %s", got, tc.field)
+		}
+	}
+	bad := "never"
+	_, err := s.UpdateSchedule(context.Background(), &proto.UpdateScheduleRequest{Id: "s", MisfirePolicy: &bad})
+	if got := violations(t, err); strings.Join(got, ",") != "misfire_policy" {
+		t.Errorf("update: violations %v, want misfire_policy", got)
+	}
+}
+
+func TestSchedules_RejectUnknownIntervalMode(t *testing.T) {
+	s := New(nil, nil, testStreams())
+	interval := int32(60)
+	_, err := s.CreateSchedule(context.Background(), &proto.CreateScheduleRequest{
+		JobId: testJobID, NextRunAt: "2030-01-01T00:00:00Z", FixedIntervalSeconds: &interval, IntervalMode: "local",
+	})
+	if got := violations(t, err); strings.Join(got, ",") != "interval_mode" {
+		t.Errorf("create: violations %v, want interval_mode", got)
+	}
+}
+
+func TestSchedules_RejectEmptyCron(t *testing.T) {
+	s := New(nil, nil, testStreams())
+	empty, interval := "", int32(60)
+	for _, req := range []*proto.CreateScheduleRequest{
+		{JobId: testJobID, NextRunAt: "2030-01-01T00:00:00Z", CronExpr: &empty},
+		{JobId: testJobID, NextRunAt: "2030-01-01T00:00:00Z", CronExpr: &empty, FixedIntervalSeconds: &interval},
+	} {
+		_, err := s.CreateSchedule(context.Background(), req)
+		if got := violations(t, err); strings.Join(got, ",") != "cron_expr" {
+			t.Errorf("create: violations %v, want cron_expr", got)
+		}
+	}
+	_, err := s.UpdateSchedule(context.Background(), &proto.UpdateScheduleRequest{Id: "s", CronExpr: &empty})
+	if got := violations(t, err); strings.Join(got, ",") != "cron_expr" {
+		t.Errorf("update: violations %v, want cron_expr", got)
+	}
+}
+
+// violations returns the fields of an InvalidArgument error's violations.
+func violations(t *testing.T, err error) []string {
+	t.Helper()
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("want InvalidArgument, got %v", err)
+	}
+	var fields []string
+	for _, d := range st.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			for _, v := range br.GetFieldViolations() {
+				fields = append(fields, v.GetField())
+			}
+		}
+	}
+	return fields
+}
+
+func TestPreviewSchedule(t *testing.T) {
+	s := New(nil, nil, testStreams())
+	cron := "30 2 * * *"
+	resp, err := s.PreviewSchedule(context.Background(), &proto.PreviewScheduleRequest{
+		CronExpr: &cron, Timezone: "America/New_York", StartAt: "2025-03-08T12:00:00Z", Count: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.FireTimes) != 2 {
+		t.Fatalf("got %d fire times", len(resp.FireTimes))
+	}
+	if ft := resp.FireTimes[0]; ft.Utc != "2025-03-09T07:30:00Z" || ft.Local != "2025-03-09T03:30:00-04:00" {
+		t.Errorf("first fire time %v", ft)
+	}
+	if resp.Description != "at 02:30 (America/New_York)" || len(resp.Warnings) == 0 {
+		t.Errorf("description %q, warnings %q", resp.Description, resp.Warnings)
+	}
+}
+
+func TestPreviewSchedule_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	mock.ExpectQuery(`FROM schedules WHERE id = \$1`).WillReturnRows(sqlmock.NewRows(nil))
+
+	s := New(db, nil, testStreams())
+	if _, err := s.PreviewSchedule(context.Background(), &proto.PreviewScheduleRequest{Id: "missing"}); status.Code(err) != codes.NotFound {
+		t.Fatalf("want NotFound, got %v", err)
+	}
+}
+
+func TestCreateSchedule_ReportsEveryBadField(t *testing.T) {
+	s := New(nil, nil, testStreams())
+	cron, interval := "0 9 * * MON#9", int32(60)
+	_, err := s.CreateSchedule(context.Background(), &proto.CreateScheduleRequest{
+		JobId: testJobID, CronExpr: &cron, FixedIntervalSeconds: &interval, Timezone: "Mars/Olympus", NextRunAt: "tomorrow",
+	})
+	fields := violations(t, err)
+	if want := []string{"cron_expr", "timezone", "next_run_at"}; strings.Join(fields, ",") != strings.Join(want, ",") {
+		t.Fatalf("violations %v, want %v", fields, want)
+	}
+}
+
+func TestCreateSchedule_UnknownJob(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	mock.ExpectQuery(`FROM jobs WHERE id = \$1`).WithArgs(testJobID).WillReturnRows(sqlmock.NewRows(jobCols))
+
+	s := New(db, nil, testStreams())
+	interval := int32(60)
+	if _, err := s.CreateSchedule(context.Background(), &proto.CreateScheduleRequest{
+		JobId: testJobID, FixedIntervalSeconds: &interval,
+	}); status.Code(err) != codes.NotFound {
+		t.Fatalf("want NotFound, got %v", err)
+	}
+}
+
+func TestCreateSchedule_ComputesNextRunAt(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	expectJob(mock)
+	next := &captureArg{}
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO schedules`).
+		WithArgs(testJobID, nil, nil, 3600, next, "UTC", true, "fire_once_now", 60, 10, "elapsed", nil, nil, nil, nil).
+		WillReturnError(errors.New("stop here"))
+
+	s := New(db, nil, testStreams())
+	interval := int32(3600)
+	before := time.Now()
+	_, _ = s.CreateSchedule(context.Background(), &proto.CreateScheduleRequest{
+		JobId: testJobID, FixedIntervalSeconds: &interval, Enabled: true,
+	})
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+	got, _ := next.v.(time.Time)
+	if got.Before(before.Add(time.Hour)) || got.After(time.Now().Add(time.Hour)) {
+		t.Fatalf("next_run_at = %v, want an hour from now", next.v)
+	}
+}
+
+func TestPreviewSchedule_SkipsCalendarDates(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	now := time.Now()
+	mock.ExpectQuery(`FROM calendars WHERE id = \$1`).WithArgs("cal").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "timezone", "created_at", "updated_at"}).
+			AddRow("cal", "holidays", "America/New_York", now, now))
+	mock.ExpectQuery(`FROM calendar_dates`).
+		WillReturnRows(sqlmock.NewRows([]string{"day", "label"}).
+			AddRow(time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC), "Christmas Day"))
+	mock.ExpectQuery(`FROM calendar_windows`).
+		WillReturnRows(sqlmock.NewRows([]string{"cron_expr", "duration_seconds", "label"}))
+
+	s := New(db, nil, testStreams())
+	cron := "0 9 * * *"
+	resp, err := s.PreviewSchedule(context.Background(), &proto.PreviewScheduleRequest{
+		CronExpr: &cron, Timezone: "America/New_York", StartAt: "2025-12-24T15:00:00Z", Count: 1,
+		CalendarIds: []string{"cal"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := resp.FireTimes[0].Local; got != "2025-12-26T09:00:00-05:00" {
+		t.Errorf("first fire time %s", got)
+	}
+	if len(resp.Suppressed) != 1 || resp.Suppressed[0].FirstAt != "2025-12-25T14:00:00Z" ||
+		resp.Suppressed[0].Reason != `date 2025-12-25 "Christmas Day"` {
+		t.Errorf("suppressed %v", resp.Suppressed)
+	}
+}
+
+func TestCreateSchedule_UnknownCalendar(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	expectJob(mock)
+	mock.ExpectQuery(`FROM calendars WHERE id = \$1`).WithArgs("missing").WillReturnRows(sqlmock.NewRows(nil))
+
+	s := New(db, nil, testStreams())
+	interval := int32(60)
+	if _, err := s.CreateSchedule(context.Background(), &proto.CreateScheduleRequest{
+		JobId: testJobID, FixedIntervalSeconds: &interval, CalendarIds: []string{"missing"},
+	}); status.Code(err) != codes.NotFound {
+		t.Fatalf("want NotFound, got %v", err)
+	}
+}
+
+func TestCreateCalendar_ValidatesExclusions(t *testing.T) {
+	s := New(nil, nil, testStreams())
+	_, err := s.CreateCalendar(context.Background(), &proto.CreateCalendarRequest{
+		Name:    "finance",
+		Dates:   []*proto.CalendarDate{{Day: "2025-12-25"}, {Day: "25/12/2025"}},
+		Windows: []*proto.CalendarWindow{{CronExpr: "0 2 * * SUN", DurationSeconds: 0}},
+		Ics:     "BEGIN:VEVENT\nDTSTART:2025\nEND:VEVENT\n",
+	})
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("want InvalidArgument, got %v", err)
+	}
+	for _, f := range []string{"dates[1].day", "windows[0]", "ics"} {
+		if !strings.Contains(st.Message(), f+":") {
+			t.Errorf("message %q does not mention %s", st.Message(), f)
+		}
+	}
+}
+
+func TestRunJob_DelayedRunIsPendingWithoutMessage(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	expectJob(mock)
+	at := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	mock.ExpectQuery(`INSERT INTO job_runs \(job_id, run_id, status, idempotency_key, scheduled_for, args, priority\)`).
+		WithArgs(testJobID, sqlmock.AnyArg(), sqlmock.AnyArg(), at, `{"command":"echo bye"}`, nil).
+		WillReturnRows(sqlmock.NewRows(runCols).
+			AddRow(1, testJobID, "run", time.Now(), nil, "queued", 0, nil, nil, nil, "key", at, 0, nil, nil))
+
+	s := New(db, nil, testStreams())
+	resp, err := s.RunJob(context.Background(), &proto.RunJobRequest{
+		Id: testJobID, ArgsJson: `{"command":"echo bye"}`, RunAt: at.Format(time.RFC3339),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetScheduledFor() != at.Format(time.RFC3339) {
+		t.Fatalf("scheduled_for = %q", resp.GetScheduledFor())
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRunJob_RejectsBadTiming(t *testing.T) {
+	s := New(nil, nil, testStreams())
+	for _, req := range []*proto.RunJobRequest{
+		{Id: testJobID, RunAt: "2000-01-01T00:00:00Z"},
+		{Id: testJobID, RunAt: "soon"},
+		{Id: testJobID, DelaySeconds: -5},
+		{Id: testJobID, RunAt: time.Now().Add(time.Hour).Format(time.RFC3339), DelaySeconds: 60},
+	} {
+		if _, err := s.RunJob(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%v: want InvalidArgument, got %v", req, err)
+		}
+	}
+}
+
+func TestCreateSchedule_FirstRunNotBeforeStartAt(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	expectJob(mock)
+	start := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO schedules`).
+		WithArgs(testJobID, nil, "0 9 * * *", nil, start.Add(9*time.Hour), "UTC", false, "fire_once_now", 60, 10, "elapsed",
+			start, nil, 3, nil).
+		WillReturnError(errors.New("stop here"))
+
+	s := New(db, nil, testStreams())
+	cron, maxRuns := "0 9 * * *", int32(3)
+	_, _ = s.CreateSchedule(context.Background(), &proto.CreateScheduleRequest{
+		JobId: testJobID, CronExpr: &cron, StartAt: start.Format(time.RFC3339), MaxRuns: &maxRuns,
+	})
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSchedules_RejectBadBounds(t *testing.T) {
+	s := New(nil, nil, testStreams())
+	interval, zero := int32(60), int32(0)
+	_, err := s.CreateSchedule(context.Background(), &proto.CreateScheduleRequest{
+		JobId: testJobID, FixedIntervalSeconds: &interval,
+		StartAt: "2030-02-01T00:00:00Z", EndAt: "2030-01-01T00:00:00Z", MaxRuns: &zero,
+	})
+	if st := status.Convert(err); st.Code() != codes.InvalidArgument ||
+		!strings.Contains(st.Message(), "end_at: must be after start_at") || !strings.Contains(st.Message(), "max_runs") {
+		t.Fatalf("got %v", err)
+	}
+
+	start, end := "2030-02-01T00:00:00Z", "2030-01-01T00:00:00Z"
+	if _, err := s.UpdateSchedule(context.Background(), &proto.UpdateScheduleRequest{
+		Id: "sc", StartAt: &start, EndAt: &end,
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("update: want InvalidArgument, got %v", err)
+	}
+}
+
+func TestRunJob_PriorityOverrideUsesTierStream(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	expectJob(mock)
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO job_runs`).WillReturnRows(runRow(jobs.StatusQueued))
+	mock.ExpectQuery(`INSERT INTO outbox`).WithArgs("jobs:high", sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectCommit()
+
+	s := New(db, nil, testStreams())
+	if _, err := s.RunJob(context.Background(), &proto.RunJobRequest{Id: testJobID, Priority: "high"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = s.RunJob(context.Background(), &proto.RunJobRequest{Id: testJobID, Priority: "urgent"})
+	if st := status.Convert(err); st.Code() != codes.InvalidArgument || !strings.Contains(st.Message(), "priority:") {
+		t.Fatalf("want InvalidArgument on priority, got %v", err)
+	}
+}
+
+func TestRunJob_QueuedJobUsesQueueStream(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	now := time.Now().UTC()
+	mock.ExpectQuery(`FROM jobs WHERE id = \$1`).WillReturnRows(sqlmock.NewRows(jobCols).
+		AddRow(testJobID, "train", "adhoc", "shell", []byte(`{"command":"train"}`), true, nil, 0, "high", "gpu", nil, now, now))
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO job_runs`).WillReturnRows(runRow(jobs.StatusQueued))
+	mock.ExpectQuery(`INSERT INTO outbox`).WithArgs("jobs:high:gpu", sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectCommit()
+
+	s := New(db, nil, testStreams())
+	if _, err := s.RunJob(context.Background(), &proto.RunJobRequest{Id: testJobID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFromProtoRetry_MaxDelayUnsetVsUncapped(t *testing.T) {
+	unset, err := fromProtoRetry(&proto.RetryPolicy{MaxAttempts: 3, Strategy: "fixed", BaseDelayMs: 60000})
+	if err != nil {
+		t.Fatalf("slow retry rejected: %v", err)
+	}
+	if unset.MaxDelayMS == nil || *unset.MaxDelayMS != 60000 {
+		t.Fatalf("unset max = %v, want the base delay", unset.MaxDelayMS)
+	}
+	zero := int64(0)
+	uncapped, err := fromProtoRetry(&proto.RetryPolicy{MaxAttempts: 3, MaxDelayMs: &zero})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uncapped.MaxDelayMS == nil || *uncapped.MaxDelayMS != 0 {
+		t.Fatalf("max_delay_ms 0 became %v", uncapped.MaxDelayMS)
+	}
+}
+
+func TestSetHostRateLimit_StoresLowercaseHost(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	s := New(db, nil, testStreams())
+
+	for _, req := range []*proto.SetHostRateLimitRequest{
+		{Host: "", RateLimit: &proto.RateLimit{Limit: 10, PeriodMs: 1000}},
+		{Host: "https://api.example.com", RateLimit: &proto.RateLimit{Limit: 10, PeriodMs: 1000}},
+		{Host: "api.example.com:8443", RateLimit: &proto.RateLimit{Limit: 10, PeriodMs: 1000}},
+		{Host: "api.example.com"},
+		{Host: "api.example.com", RateLimit: &proto.RateLimit{Limit: 10}},
+	} {
+		if _, err := s.SetHostRateLimit(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%q %v: want InvalidArgument, got %v", req.Host, req.RateLimit, err)
+		}
+	}
+
+	mock.ExpectQuery(`INSERT INTO host_rate_limits`).WithArgs("api.example.com", `{"limit":10,"period_ms":1000}`).
+		WillReturnRows(sqlmock.NewRows([]string{"host", "rate_limit", "updated_at"}).
+			AddRow("api.example.com", []byte(`{"limit":10,"period_ms":1000}`), time.Now()))
+	resp, err := s.SetHostRateLimit(context.Background(), &proto.SetHostRateLimitRequest{
+		Host: " API.Example.com ", RateLimit: &proto.RateLimit{Limit: 10, PeriodMs: 1000},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := resp.GetLimit(); got.GetHost() != "api.example.com" || got.GetRateLimit().GetLimit() != 10 {
+		t.Fatalf("limit = %v", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
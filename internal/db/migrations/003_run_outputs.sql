@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS run_outputs (
+    id BIGSERIAL PRIMARY KEY,
+    run_id UUID NOT NULL,
+    attempt INT NOT NULL,
+    exit_code INT,
+    http_status INT,
+    stdout BYTEA NOT NULL DEFAULT ''::bytea,
+    stderr BYTEA NOT NULL DEFAULT ''::bytea,
+    stdout_truncated BOOLEAN NOT NULL DEFAULT false,
+    stderr_truncated BOOLEAN NOT NULL DEFAULT false,
+    stdout_bytes INT NOT NULL DEFAULT 0,
+    stderr_bytes INT NOT NULL DEFAULT 0,
+    compression TEXT NOT NULL DEFAULT 'none' CHECK (compression IN ('none','gzip')),
+    error_text TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (run_id, attempt)
+);
@@ -0,0 +1,3 @@
+ALTER TABLE job_runs
+    ADD COLUMN IF NOT EXISTS error_class TEXT
+    CHECK (error_class IN ('transient', 'permanent', 'rate_limited'));
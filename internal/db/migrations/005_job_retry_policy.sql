@@ -0,0 +1,2 @@
+-- NULL means "use the worker's default policy"
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS retry_policy JSONB;
@@ -0,0 +1,6 @@
+-- Handlers are validated against the handler registry (built-ins plus
+-- plugins), so the hard-coded list no longer applies.
+ALTER TABLE jobs DROP CONSTRAINT IF EXISTS jobs_handler_check;
+ALTER TABLE jobs DROP CONSTRAINT IF EXISTS jobs_handler_nonempty;
+ALTER TABLE jobs
+    ADD CONSTRAINT jobs_handler_nonempty CHECK (handler <> '');
@@ -0,0 +1,4 @@
+-- Per-job limit on simultaneously running runs across all workers; 0 = no limit.
+ALTER TABLE jobs
+    ADD COLUMN IF NOT EXISTS max_concurrency INT NOT NULL DEFAULT 0
+    CHECK (max_concurrency >= 0);
@@ -0,0 +1,4 @@
+ALTER TABLE job_runs DROP CONSTRAINT IF EXISTS job_runs_status_check;
+ALTER TABLE job_runs
+    ADD CONSTRAINT job_runs_status_check
+    CHECK (status IN ('queued','running','success','failed','retried','dead','canceled'));
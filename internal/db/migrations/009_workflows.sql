@@ -0,0 +1,57 @@
+-- Workflows are DAGs of jobs. A job appears at most once per workflow; its
+-- trigger rule decides when it runs relative to its upstream jobs.
+CREATE TABLE IF NOT EXISTS workflows (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name TEXT NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS workflow_nodes (
+    workflow_id UUID NOT NULL REFERENCES workflows(id) ON DELETE CASCADE,
+    job_id UUID NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    trigger_rule TEXT NOT NULL DEFAULT 'all_success'
+        CHECK (trigger_rule IN ('all_success','all_done','one_failed')),
+    PRIMARY KEY (workflow_id, job_id)
+);
+
+CREATE TABLE IF NOT EXISTS workflow_edges (
+    workflow_id UUID NOT NULL,
+    from_job_id UUID NOT NULL,
+    to_job_id UUID NOT NULL,
+    PRIMARY KEY (workflow_id, from_job_id, to_job_id),
+    FOREIGN KEY (workflow_id, from_job_id) REFERENCES workflow_nodes(workflow_id, job_id) ON DELETE CASCADE,
+    FOREIGN KEY (workflow_id, to_job_id) REFERENCES workflow_nodes(workflow_id, job_id) ON DELETE CASCADE,
+    CHECK (from_job_id <> to_job_id)
+);
+
+CREATE TABLE IF NOT EXISTS workflow_runs (
+    id UUID PRIMARY KEY,
+    workflow_id UUID NOT NULL REFERENCES workflows(id) ON DELETE CASCADE,
+    status TEXT NOT NULL DEFAULT 'running'
+        CHECK (status IN ('running','success','failed','canceled')),
+    started_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    finished_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_workflow_runs_workflow_started
+    ON workflow_runs(workflow_id, started_at DESC);
+
+-- One row per node of a workflow run. run_id is the node's job_runs.run_id
+-- once it has been enqueued; skipped nodes never get one.
+CREATE TABLE IF NOT EXISTS workflow_run_nodes (
+    workflow_run_id UUID NOT NULL REFERENCES workflow_runs(id) ON DELETE CASCADE,
+    job_id UUID NOT NULL,
+    run_id UUID,
+    skipped BOOLEAN NOT NULL DEFAULT false,
+    PRIMARY KEY (workflow_run_id, job_id)
+);
+
+-- A schedule triggers either a job or a workflow.
+ALTER TABLE schedules ALTER COLUMN job_id DROP NOT NULL;
+ALTER TABLE schedules
+    ADD COLUMN IF NOT EXISTS workflow_id UUID REFERENCES workflows(id) ON DELETE CASCADE;
+ALTER TABLE schedules DROP CONSTRAINT IF EXISTS schedules_target_check;
+ALTER TABLE schedules
+    ADD CONSTRAINT schedules_target_check CHECK ((job_id IS NULL) <> (workflow_id IS NULL));
@@ -0,0 +1,26 @@
+-- What the scheduler does with occurrences it missed (e.g. no leader for a
+-- while). Occurrences later than misfire_grace_seconds count as missed.
+ALTER TABLE schedules
+    ADD COLUMN IF NOT EXISTS misfire_policy TEXT NOT NULL DEFAULT 'fire_once_now'
+    CHECK (misfire_policy IN ('fire_all','fire_once_now','skip_to_next'));
+ALTER TABLE schedules
+    ADD COLUMN IF NOT EXISTS misfire_grace_seconds INT NOT NULL DEFAULT 60
+    CHECK (misfire_grace_seconds >= 0);
+-- fire_all: most missed occurrences fired in one catch-up
+ALTER TABLE schedules
+    ADD COLUMN IF NOT EXISTS max_catchup INT NOT NULL DEFAULT 10
+    CHECK (max_catchup >= 1);
+
+-- One row per catch-up that dropped occurrences.
+CREATE TABLE IF NOT EXISTS schedule_misfires (
+    id BIGSERIAL PRIMARY KEY,
+    schedule_id UUID NOT NULL REFERENCES schedules(id) ON DELETE CASCADE,
+    policy TEXT NOT NULL,
+    missed_count INT NOT NULL,
+    first_missed_at TIMESTAMPTZ NOT NULL,
+    last_missed_at TIMESTAMPTZ NOT NULL,
+    detected_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_schedule_misfires_schedule_detected
+    ON schedule_misfires(schedule_id, detected_at DESC);
@@ -0,0 +1,10 @@
+-- How fixed intervals are counted. 'elapsed' adds real seconds (DST changes
+-- shift the local time); 'wall_clock' adds to the local time and keeps the
+-- time of day of interval_anchor.
+ALTER TABLE schedules
+    ADD COLUMN IF NOT EXISTS interval_mode TEXT NOT NULL DEFAULT 'elapsed'
+    CHECK (interval_mode IN ('elapsed','wall_clock'));
+ALTER TABLE schedules
+    ADD COLUMN IF NOT EXISTS interval_anchor TIMESTAMPTZ;
+UPDATE schedules SET interval_anchor = next_run_at WHERE interval_anchor IS NULL;
+ALTER TABLE schedules ALTER COLUMN interval_anchor SET NOT NULL;
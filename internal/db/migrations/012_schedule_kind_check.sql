@@ -0,0 +1,13 @@
+-- A schedule is either a cron or a fixed interval, never both or neither.
+-- Cron took precedence over the interval before, so keep it where both are set.
+UPDATE schedules SET cron_expr = NULL WHERE cron_expr = '';
+UPDATE schedules SET fixed_interval_seconds = NULL
+    WHERE cron_expr IS NOT NULL AND fixed_interval_seconds IS NOT NULL;
+-- NOT VALID: rows with neither kind never fired and are left for an operator
+-- to fix or delete; every insert and update is checked.
+ALTER TABLE schedules DROP CONSTRAINT IF EXISTS schedules_kind_check;
+ALTER TABLE schedules
+    ADD CONSTRAINT schedules_kind_check
+    CHECK ((cron_expr IS NOT NULL) <> (fixed_interval_seconds IS NOT NULL)
+           AND (fixed_interval_seconds IS NULL OR fixed_interval_seconds > 0))
+    NOT VALID;
@@ -0,0 +1,50 @@
+-- Calendars exclude schedule occurrences: whole dates (holidays, entered by
+-- hand or imported from ICS) and recurring windows, each a cron start plus a
+-- duration. Dates and window crons are read in the calendar's timezone.
+CREATE TABLE IF NOT EXISTS calendars (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name TEXT NOT NULL UNIQUE,
+    timezone TEXT NOT NULL DEFAULT 'UTC',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS calendar_dates (
+    calendar_id UUID NOT NULL REFERENCES calendars(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    label TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (calendar_id, day)
+);
+
+CREATE TABLE IF NOT EXISTS calendar_windows (
+    id BIGSERIAL PRIMARY KEY,
+    calendar_id UUID NOT NULL REFERENCES calendars(id) ON DELETE CASCADE,
+    cron_expr TEXT NOT NULL,
+    duration_seconds INT NOT NULL CHECK (duration_seconds > 0),
+    label TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS idx_calendar_windows_calendar ON calendar_windows(calendar_id);
+
+CREATE TABLE IF NOT EXISTS schedule_calendars (
+    schedule_id UUID NOT NULL REFERENCES schedules(id) ON DELETE CASCADE,
+    calendar_id UUID NOT NULL REFERENCES calendars(id) ON DELETE RESTRICT,
+    PRIMARY KEY (schedule_id, calendar_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_schedule_calendars_calendar ON schedule_calendars(calendar_id);
+
+-- One row per run of consecutive occurrences a calendar suppressed.
+CREATE TABLE IF NOT EXISTS schedule_suppressions (
+    id BIGSERIAL PRIMARY KEY,
+    schedule_id UUID NOT NULL REFERENCES schedules(id) ON DELETE CASCADE,
+    calendar_id UUID NOT NULL REFERENCES calendars(id) ON DELETE CASCADE,
+    reason TEXT NOT NULL,
+    suppressed_count INT NOT NULL,
+    first_at TIMESTAMPTZ NOT NULL,
+    last_at TIMESTAMPTZ NOT NULL,
+    detected_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_schedule_suppressions_schedule_detected
+    ON schedule_suppressions(schedule_id, detected_at DESC);
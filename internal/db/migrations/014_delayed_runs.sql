@@ -0,0 +1,11 @@
+-- One-off runs for a later time. Such a run is queued with scheduled_for set
+-- and no stream message; the scheduler publishes it when it is due and sets
+-- released_at. args holds the run's arguments until then.
+ALTER TABLE job_runs
+    ADD COLUMN IF NOT EXISTS scheduled_for TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS released_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS args JSONB;
+
+CREATE INDEX IF NOT EXISTS idx_job_runs_pending
+    ON job_runs(scheduled_for)
+    WHERE scheduled_for IS NOT NULL AND released_at IS NULL AND status = 'queued';
@@ -0,0 +1,14 @@
+-- Lifetime bounds. Occurrences before start_at or paused_until are skipped;
+-- the scheduler disables a schedule once it is past end_at or has fired
+-- max_runs times. fire_count counts enqueued occurrences.
+ALTER TABLE schedules
+    ADD COLUMN IF NOT EXISTS start_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS end_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS max_runs INT CHECK (max_runs >= 1),
+    ADD COLUMN IF NOT EXISTS paused_until TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS fire_count BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE schedules DROP CONSTRAINT IF EXISTS schedules_bounds_check;
+ALTER TABLE schedules
+    ADD CONSTRAINT schedules_bounds_check
+    CHECK (start_at IS NULL OR end_at IS NULL OR start_at < end_at);
@@ -0,0 +1,7 @@
+-- Highest fencing token of each leader election that has written. The
+-- scheduler claims its token when it becomes leader and checks it in every
+-- scan transaction, so a replaced leader's writes are rejected.
+CREATE TABLE IF NOT EXISTS scheduler_fence (
+    name TEXT PRIMARY KEY,
+    token BIGINT NOT NULL
+);
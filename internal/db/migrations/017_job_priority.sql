@@ -0,0 +1,8 @@
+-- Runs are published on the stream of their priority tier. Jobs carry a
+-- default; a delayed RunJob keeps its override here until it is released.
+ALTER TABLE jobs
+    ADD COLUMN IF NOT EXISTS priority TEXT NOT NULL DEFAULT 'normal'
+    CHECK (priority IN ('high','normal','low'));
+ALTER TABLE job_runs
+    ADD COLUMN IF NOT EXISTS priority TEXT
+    CHECK (priority IN ('high','normal','low'));
@@ -0,0 +1,4 @@
+-- Runs are published on the streams of their job's queue, which only the
+-- workers subscribed to it read.
+ALTER TABLE jobs
+    ADD COLUMN IF NOT EXISTS queue TEXT NOT NULL DEFAULT 'default';
@@ -0,0 +1,9 @@
+-- Per-job rate limit ({"limit":..,"period_ms":..,"burst":..}); NULL = none.
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS rate_limit JSONB;
+
+-- Runs a rate limit sent back to wait: how often, when last, and which
+-- limit ("job" or "host:<name>").
+ALTER TABLE job_runs
+    ADD COLUMN IF NOT EXISTS throttle_count INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS throttled_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS throttled_by TEXT;
@@ -0,0 +1,8 @@
+-- Rate limits per HTTP host, shared by every job whose http runs call it.
+-- Hosts are stored lowercase, as workers compare them.
+CREATE TABLE IF NOT EXISTS host_rate_limits (
+    host        TEXT PRIMARY KEY CHECK (host <> '' AND host = lower(host)),
+    rate_limit  JSONB NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
@@ -0,0 +1,390 @@
+package jobs
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Calendar excludes schedule occurrences that fall on one of its dates or
+// inside one of its windows, both read in the calendar's timezone.
+type Calendar struct {
+	ID        string           `json:"id"`
+	Name      string           `json:"name"`
+	Timezone  string           `json:"timezone"`
+	Dates     []CalendarDate   `json:"dates"`
+	Windows   []CalendarWindow `json:"windows"`
+	CreatedAt time.Time        `json:"created_at"`
+	UpdatedAt time.Time        `json:"updated_at"`
+}
+
+// CalendarDate excludes a whole local day. Day is midnight UTC of that date.
+type CalendarDate struct {
+	Day   time.Time `json:"day"`
+	Label string    `json:"label,omitempty"`
+}
+
+// CalendarWindow excludes DurationSeconds from every start of CronExpr,
+// e.g. "0 2 * * SUN" for 4h is a Sunday maintenance window.
+type CalendarWindow struct {
+	CronExpr        string `json:"cron_expr"`
+	DurationSeconds int    `json:"duration_seconds"`
+	Label           string `json:"label,omitempty"`
+}
+
+// ScheduleSuppression records consecutive occurrences a calendar stopped.
+type ScheduleSuppression struct {
+	ID              int64     `json:"id"`
+	ScheduleID      string    `json:"schedule_id"`
+	CalendarID      string    `json:"calendar_id"`
+	Reason          string    `json:"reason"`
+	SuppressedCount int       `json:"suppressed_count"`
+	FirstAt         time.Time `json:"first_at"`
+	LastAt          time.Time `json:"last_at"`
+	DetectedAt      time.Time `json:"detected_at"`
+}
+
+/* ===================== Calendars ===================== */
+
+const calendarColumns = `id, name, timezone, created_at, updated_at`
+
+type CreateCalendarParams struct {
+	Name     string
+	Timezone string
+	Dates    []CalendarDate
+	Windows  []CalendarWindow
+}
+
+func (s *Store) CreateCalendar(ctx context.Context, p CreateCalendarParams) (*Calendar, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+
+	tx, err := s.DB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var c Calendar
+	if err := tx.QueryRowContext(ctx, `
+INSERT INTO calendars (name, timezone) VALUES ($1, $2)
+RETURNING `+calendarColumns, p.Name, p.Timezone).
+		Scan(&c.ID, &c.Name, &c.Timezone, &c.CreatedAt, &c.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if err := insertDates(ctx, tx, c.ID, p.Dates); err != nil {
+		return nil, err
+	}
+	if err := insertWindows(ctx, tx, c.ID, p.Windows); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	c.Dates, c.Windows = p.Dates, p.Windows
+	return &c, nil
+}
+
+// insertDates adds dates to a calendar; a date it already has keeps its
+// label unless the new one is set.
+func insertDates(ctx context.Context, tx *sql.Tx, id string, dates []CalendarDate) error {
+	for _, d := range dates {
+		if _, err := tx.ExecContext(ctx, `
+INSERT INTO calendar_dates (calendar_id, day, label) VALUES ($1, $2, $3)
+ON CONFLICT (calendar_id, day) DO UPDATE
+SET label = CASE WHEN EXCLUDED.label = '' THEN calendar_dates.label ELSE EXCLUDED.label END`,
+			id, d.Day, d.Label); err != nil {
+			return fmt.Errorf("date %s: %w", d.Day.Format(time.DateOnly), err)
+		}
+	}
+	return nil
+}
+
+func insertWindows(ctx context.Context, tx *sql.Tx, id string, windows []CalendarWindow) error {
+	for _, w := range windows {
+		if _, err := tx.ExecContext(ctx, `
+INSERT INTO calendar_windows (calendar_id, cron_expr, duration_seconds, label) VALUES ($1, $2, $3, $4)`,
+			id, w.CronExpr, w.DurationSeconds, w.Label); err != nil {
+			return fmt.Errorf("window %q: %w", w.CronExpr, err)
+		}
+	}
+	return nil
+}
+
+func (s *Store) GetCalendar(ctx context.Context, id string) (*Calendar, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	var c Calendar
+	if err := s.DB.QueryRowContext(ctx, `SELECT `+calendarColumns+` FROM calendars WHERE id = $1`, id).
+		Scan(&c.ID, &c.Name, &c.Timezone, &c.CreatedAt, &c.UpdatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	if err := loadExclusions(ctx, s.DB, &c); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func loadExclusions(ctx context.Context, db queryer, c *Calendar) error {
+	rows, err := db.QueryContext(ctx, `
+SELECT day, label FROM calendar_dates WHERE calendar_id = $1 ORDER BY day`, c.ID)
+	if err != nil {
+		return err
+	}
+	c.Dates = nil
+	for rows.Next() {
+		var d CalendarDate
+		if err := rows.Scan(&d.Day, &d.Label); err != nil {
+			rows.Close()
+			return err
+		}
+		c.Dates = append(c.Dates, d)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	rows, err = db.QueryContext(ctx, `
+SELECT cron_expr, duration_seconds, label FROM calendar_windows WHERE calendar_id = $1 ORDER BY id`, c.ID)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	c.Windows = nil
+	for rows.Next() {
+		var w CalendarWindow
+		if err := rows.Scan(&w.CronExpr, &w.DurationSeconds, &w.Label); err != nil {
+			return err
+		}
+		c.Windows = append(c.Windows, w)
+	}
+	return rows.Err()
+}
+
+type ListCalendarsParams struct {
+	Limit  int
+	Offset int
+}
+
+// ListCalendars returns calendars without their dates and windows.
+func (s *Store) ListCalendars(ctx context.Context, p ListCalendarsParams) ([]Calendar, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+
+	if p.Limit <= 0 || p.Limit > 200 {
+		p.Limit = 50
+	}
+	rows, err := s.DB.QueryContext(ctx, `SELECT `+calendarColumns+`
+FROM calendars
+ORDER BY name
+LIMIT $1 OFFSET $2;`, p.Limit, p.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []Calendar
+	for rows.Next() {
+		var c Calendar
+		if err := rows.Scan(&c.ID, &c.Name, &c.Timezone, &c.CreatedAt, &c.UpdatedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	return out, rows.Err()
+}
+
+type UpdateCalendarParams struct {
+	ID       string
+	Name     *string
+	Timezone *string
+	Dates    *[]CalendarDate   // replaces all dates
+	Windows  *[]CalendarWindow // replaces all windows
+	AddDates []CalendarDate    // merged into the dates (ICS import)
+}
+
+func (s *Store) UpdateCalendar(ctx context.Context, p UpdateCalendarParams) (*Calendar, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+
+	set := ""
+	args := []any{}
+	i := 1
+	if p.Name != nil {
+		set += fmt.Sprintf("name = $%d,", i)
+		args = append(args, *p.Name)
+		i++
+	}
+	if p.Timezone != nil {
+		set += fmt.Sprintf("timezone = $%d,", i)
+		args = append(args, *p.Timezone)
+		i++
+	}
+	set += "updated_at = now()"
+	args = append(args, p.ID)
+
+	tx, err := s.DB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	res, err := tx.ExecContext(ctx, fmt.Sprintf(`UPDATE calendars SET %s WHERE id = $%d`, set, i), args...)
+	if err != nil {
+		return nil, err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil, ErrNotFound
+	}
+	if p.Dates != nil {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM calendar_dates WHERE calendar_id = $1`, p.ID); err != nil {
+			return nil, err
+		}
+		if err := insertDates(ctx, tx, p.ID, *p.Dates); err != nil {
+			return nil, err
+		}
+	}
+	if err := insertDates(ctx, tx, p.ID, p.AddDates); err != nil {
+		return nil, err
+	}
+	if p.Windows != nil {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM calendar_windows WHERE calendar_id = $1`, p.ID); err != nil {
+			return nil, err
+		}
+		if err := insertWindows(ctx, tx, p.ID, *p.Windows); err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return s.GetCalendar(ctx, p.ID)
+}
+
+// ErrCalendarInUse is returned when deleting a calendar schedules still use.
+var ErrCalendarInUse = errors.New("calendar is used by schedules")
+
+func (s *Store) DeleteCalendar(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	var inUse bool
+	if err := s.DB.QueryRowContext(ctx, `
+SELECT EXISTS (SELECT 1 FROM schedule_calendars WHERE calendar_id = $1)`, id).Scan(&inUse); err != nil {
+		return err
+	}
+	if inUse {
+		return ErrCalendarInUse
+	}
+	res, err := s.DB.ExecContext(ctx, `DELETE FROM calendars WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+/* ===================== Schedule calendars ===================== */
+
+// setScheduleCalendars replaces the calendars a schedule uses.
+func setScheduleCalendars(ctx context.Context, tx *sql.Tx, scheduleID string, calendarIDs []string) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM schedule_calendars WHERE schedule_id = $1`, scheduleID); err != nil {
+		return err
+	}
+	for _, id := range calendarIDs {
+		if _, err := tx.ExecContext(ctx, `
+INSERT INTO schedule_calendars (schedule_id, calendar_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`,
+			scheduleID, id); err != nil {
+			return fmt.Errorf("calendar %s: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// ScheduleCalendarsTx loads the calendars of a schedule, with their dates
+// and windows, inside the caller's transaction.
+func (s *Store) ScheduleCalendarsTx(ctx context.Context, tx *sql.Tx, scheduleID string) ([]Calendar, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	return scheduleCalendars(ctx, tx, scheduleID)
+}
+
+func (s *Store) ScheduleCalendars(ctx context.Context, scheduleID string) ([]Calendar, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	return scheduleCalendars(ctx, s.DB, scheduleID)
+}
+
+func scheduleCalendars(ctx context.Context, db queryer, scheduleID string) ([]Calendar, error) {
+	rows, err := db.QueryContext(ctx, `
+SELECT c.id, c.name, c.timezone, c.created_at, c.updated_at
+FROM calendars c JOIN schedule_calendars sc ON sc.calendar_id = c.id
+WHERE sc.schedule_id = $1
+ORDER BY c.name`, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+	var out []Calendar
+	for rows.Next() {
+		var c Calendar
+		if err := rows.Scan(&c.ID, &c.Name, &c.Timezone, &c.CreatedAt, &c.UpdatedAt); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	for i := range out {
+		if err := loadExclusions(ctx, db, &out[i]); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func (s *Store) InsertSuppressionTx(ctx context.Context, tx *sql.Tx, su ScheduleSuppression) error {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	_, err := tx.ExecContext(ctx, `
+INSERT INTO schedule_suppressions (schedule_id, calendar_id, reason, suppressed_count, first_at, last_at)
+VALUES ($1, $2, $3, $4, $5, $6)`, su.ScheduleID, su.CalendarID, su.Reason, su.SuppressedCount, su.FirstAt, su.LastAt)
+	return err
+}
+
+func (s *Store) ListSuppressions(ctx context.Context, scheduleID string, limit int) ([]ScheduleSuppression, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	if limit <= 0 || limit > 200 {
+		limit = 50
+	}
+	rows, err := s.DB.QueryContext(ctx, `
+SELECT id, schedule_id, calendar_id, reason, suppressed_count, first_at, last_at, detected_at
+FROM schedule_suppressions
+WHERE schedule_id = $1
+ORDER BY detected_at DESC, id DESC
+LIMIT $2;`, scheduleID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []ScheduleSuppression
+	for rows.Next() {
+		var su ScheduleSuppression
+		if err := rows.Scan(&su.ID, &su.ScheduleID, &su.CalendarID, &su.Reason, &su.SuppressedCount, &su.FirstAt, &su.LastAt, &su.DetectedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, su)
+	}
+	return out, rows.Err()
+}
@@ -5,25 +5,148 @@ import (
 )
 
 type Job struct {
-	ID        string         `json:"id"`
-	Name      string         `json:"name"`
-	Type      string         `json:"type"`
-	Handler   string         `json:"handler"` // "shell" | "http"
-	Args      map[string]any `json:"args"`
-	Enabled   bool           `json:"enabled"`
-	CreatedAt time.Time      `json:"created_at"`
-	UpdatedAt time.Time      `json:"updated_at"`
+	ID             string         `json:"id"`
+	Name           string         `json:"name"`
+	Type           string         `json:"type"`
+	Handler        string         `json:"handler"` // registered handler name
+	Args           map[string]any `json:"args"`
+	Enabled        bool           `json:"enabled"`
+	RetryPolicy    *RetryPolicy   `json:"retry_policy,omitempty"` // nil = worker default
+	MaxConcurrency int            `json:"max_concurrency"`        // 0 = unlimited
+	Priority       Priority       `json:"priority"`
+	Queue          string         `json:"queue"`                // workers subscribed to it run the job
+	RateLimit      *RateLimit     `json:"rate_limit,omitempty"` // nil = unlimited
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
 }
 
+// Priority picks the stream tier a job's runs are published on.
+type Priority string
+
+const (
+	PriorityHigh   Priority = "high"
+	PriorityNormal Priority = "normal"
+	PriorityLow    Priority = "low"
+)
+
+func (p Priority) Valid() bool {
+	switch p {
+	case PriorityHigh, PriorityNormal, PriorityLow:
+		return true
+	}
+	return false
+}
+
+// RunMessage builds the stream payload that tells a worker to execute one
+// run of this job. The retry policy travels with the message so the worker
+// does not need a DB lookup. Workers read the concurrency and rate limits
+// from the job row, so that changes reach queued runs; the copies here are
+// used only when the row cannot be read.
+func (j Job) RunMessage(runID string) map[string]any {
+	m := map[string]any{
+		"run_id":  runID,
+		"job_id":  j.ID,
+		"handler": j.Handler,
+		"args":    j.Args,
+	}
+	if j.RetryPolicy != nil {
+		m["retry_policy"] = j.RetryPolicy
+	}
+	if j.MaxConcurrency > 0 {
+		m["max_concurrency"] = j.MaxConcurrency
+	}
+	if j.RateLimit != nil {
+		m["rate_limit"] = j.RateLimit
+	}
+	return m
+}
+
+// Schedule triggers either a job or a workflow (exactly one of JobID and
+// WorkflowID is set).
 type Schedule struct {
 	ID                   string     `json:"id"`
-	JobID                string     `json:"job_id"`
+	JobID                string     `json:"job_id,omitempty"`
+	WorkflowID           *string    `json:"workflow_id,omitempty"`
 	CronExpr             *string    `json:"cron_expr,omitempty"`
 	FixedIntervalSeconds *int       `json:"fixed_interval_seconds,omitempty"`
 	NextRunAt            time.Time  `json:"next_run_at"`
 	Timezone             string     `json:"timezone"`
 	LastEnqueuedAt       *time.Time `json:"last_enqueued_at,omitempty"`
 	Enabled              bool       `json:"enabled"`
+
+	MisfirePolicy       MisfirePolicy `json:"misfire_policy"`
+	MisfireGraceSeconds int           `json:"misfire_grace_seconds"` // later than this counts as missed
+	MaxCatchup          int           `json:"max_catchup"`           // fire_all: most occurrences fired at once
+
+	IntervalMode   IntervalMode `json:"interval_mode"`
+	IntervalAnchor time.Time    `json:"interval_anchor"` // wall_clock intervals count from here
+
+	CalendarIDs []string `json:"calendar_ids,omitempty"` // occurrences these exclude are suppressed
+
+	StartAt     *time.Time `json:"start_at,omitempty"`     // earlier occurrences are skipped
+	EndAt       *time.Time `json:"end_at,omitempty"`       // later occurrences are not fired; the schedule is disabled
+	MaxRuns     *int       `json:"max_runs,omitempty"`     // disabled once FireCount reaches it
+	PausedUntil *time.Time `json:"paused_until,omitempty"` // occurrences until then are skipped
+	FireCount   int64      `json:"fire_count"`
+}
+
+// HoldUntil is the time before which the schedule fires nothing: the later
+// of StartAt and PausedUntil, or the zero time.
+func (sc Schedule) HoldUntil() time.Time {
+	var t time.Time
+	for _, v := range []*time.Time{sc.StartAt, sc.PausedUntil} {
+		if v != nil && v.After(t) {
+			t = *v
+		}
+	}
+	return t
+}
+
+// IntervalMode decides how a fixed interval is added across DST changes.
+type IntervalMode string
+
+const (
+	IntervalElapsed   IntervalMode = "elapsed"    // real seconds; the local time shifts with DST
+	IntervalWallClock IntervalMode = "wall_clock" // local time; a daily interval stays at the same time of day
+)
+
+func (m IntervalMode) Valid() bool {
+	return m == IntervalElapsed || m == IntervalWallClock
+}
+
+// MisfirePolicy decides what happens to occurrences a schedule missed.
+type MisfirePolicy string
+
+const (
+	MisfireFireAll     MisfirePolicy = "fire_all"      // fire every missed occurrence, up to MaxCatchup
+	MisfireFireOnceNow MisfirePolicy = "fire_once_now" // fire once for the latest one
+	MisfireSkipToNext  MisfirePolicy = "skip_to_next"  // fire none of them
+)
+
+func (p MisfirePolicy) Valid() bool {
+	switch p {
+	case MisfireFireAll, MisfireFireOnceNow, MisfireSkipToNext:
+		return true
+	}
+	return false
+}
+
+// Defaults for schedules created without misfire settings.
+const (
+	DefaultMisfirePolicy       = MisfireFireOnceNow
+	DefaultMisfireGraceSeconds = 60
+	DefaultMaxCatchup          = 10
+)
+
+// ScheduleMisfire records occurrences a schedule missed and did not fire.
+type ScheduleMisfire struct {
+	ID            int64         `json:"id"`
+	ScheduleID    string        `json:"schedule_id"`
+	Policy        MisfirePolicy `json:"policy"`
+	MissedCount   int           `json:"missed_count"`
+	FirstMissedAt time.Time     `json:"first_missed_at"`
+	LastMissedAt  time.Time     `json:"last_missed_at"`
+	DetectedAt    time.Time     `json:"detected_at"`
 }
 
 type JobRunStatus string
@@ -35,8 +158,20 @@ const (
 	StatusFailed  JobRunStatus = "failed"
 	StatusRetried JobRunStatus = "retried"
 	StatusDead    JobRunStatus = "dead"
+	// StatusCanceled is set by CancelRun. It is final: workers skip the run
+	// and later status updates leave it alone.
+	StatusCanceled JobRunStatus = "canceled"
 )
 
+// Terminal reports whether a run in this status will not be executed again.
+func (s JobRunStatus) Terminal() bool {
+	switch s {
+	case StatusSuccess, StatusFailed, StatusDead, StatusCanceled:
+		return true
+	}
+	return false
+}
+
 type JobRun struct {
 	ID             int64        `json:"id"`
 	JobID          string       `json:"job_id"`
@@ -46,6 +181,11 @@ type JobRun struct {
 	Status         JobRunStatus `json:"status"`
 	Attempts       int          `json:"attempts"`
 	ErrorText      *string      `json:"error_text,omitempty"`
+	ErrorClass     *string      `json:"error_class,omitempty"` // transient | permanent | rate_limited
 	WorkerID       *string      `json:"worker_id,omitempty"`
 	IdempotencyKey string       `json:"idempotency_key"`
+	ScheduledFor   *time.Time   `json:"scheduled_for,omitempty"` // delayed runs only
+	ThrottleCount  int          `json:"throttle_count"`          // times a rate limit sent it back to wait
+	ThrottledAt    *time.Time   `json:"throttled_at,omitempty"`
+	ThrottledBy    *string      `json:"throttled_by,omitempty"` // "job" or "host:<name>"
 }
@@ -0,0 +1,187 @@
+package jobs
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"time"
+	"unicode/utf8"
+)
+
+// RunOutput is what a handler produced for one attempt of a run. For the
+// http handler Stdout holds the response body.
+type RunOutput struct {
+	RunID           string    `json:"run_id"`
+	Attempt         int       `json:"attempt"`
+	ExitCode        *int      `json:"exit_code,omitempty"`
+	HTTPStatus      *int      `json:"http_status,omitempty"`
+	Stdout          string    `json:"stdout"`
+	Stderr          string    `json:"stderr"`
+	StdoutTruncated bool      `json:"stdout_truncated"`
+	StderrTruncated bool      `json:"stderr_truncated"`
+	StdoutBytes     int       `json:"stdout_bytes"` // size before truncation
+	StderrBytes     int       `json:"stderr_bytes"`
+	ErrorText       *string   `json:"error_text,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// OutputLimits controls how much output is kept per stream and how it is stored.
+type OutputLimits struct {
+	MaxBytes int  // per stream; <= 0 means unlimited
+	Compress bool // gzip stdout/stderr at rest
+}
+
+func DefaultOutputLimits() OutputLimits {
+	return OutputLimits{MaxBytes: 64 << 10, Compress: true}
+}
+
+// TruncateOutput keeps the head and tail of s within max bytes, marker
+// included, and puts a marker in between saying how much was dropped. It
+// cuts only between runes. If max leaves no room for the marker, only the
+// head is kept.
+func TruncateOutput(s string, max int) (string, bool) {
+	if max <= 0 || len(s) <= max {
+		return s, false
+	}
+	// the marker's size depends on the count it reports, which depends on
+	// the marker's size; it settles once the count stops gaining digits
+	dropped := len(s) - max
+	for {
+		marker := fmt.Sprintf("\n...[truncated %d bytes]...\n", dropped)
+		keep := max - len(marker)
+		if keep <= 0 {
+			return s[:runeStart(s, max)], true
+		}
+		head := runeStart(s, keep/2)
+		tail := len(s) - (keep - keep/2)
+		for tail < len(s) && !utf8.RuneStart(s[tail]) {
+			tail++
+		}
+		if tail-head == dropped {
+			return s[:head] + marker + s[tail:], true
+		}
+		dropped = tail - head
+	}
+}
+
+// runeStart backs i up to the start of the rune it falls in.
+func runeStart(s string, i int) int {
+	for i > 0 && i < len(s) && !utf8.RuneStart(s[i]) {
+		i--
+	}
+	return i
+}
+
+func encodeOutput(s string, compress bool) ([]byte, error) {
+	if !compress || s == "" {
+		return []byte(s), nil
+	}
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write([]byte(s)); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeOutput(b []byte, compression string) (string, error) {
+	if compression != "gzip" || len(b) == 0 {
+		return string(b), nil
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		return "", err
+	}
+	defer zr.Close()
+	out, err := io.ReadAll(zr)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// SaveRunOutput stores the output of one attempt, truncated and optionally
+// compressed per lim. A redelivered attempt overwrites its previous row.
+func (s *Store) SaveRunOutput(ctx context.Context, o RunOutput, lim OutputLimits) error {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+
+	stdout, stdoutTrunc := TruncateOutput(o.Stdout, lim.MaxBytes)
+	stderr, stderrTrunc := TruncateOutput(o.Stderr, lim.MaxBytes)
+	compression := "none"
+	if lim.Compress {
+		compression = "gzip"
+	}
+	outB, err := encodeOutput(stdout, lim.Compress)
+	if err != nil {
+		return err
+	}
+	errB, err := encodeOutput(stderr, lim.Compress)
+	if err != nil {
+		return err
+	}
+
+	q := `
+INSERT INTO run_outputs (run_id, attempt, exit_code, http_status, stdout, stderr,
+    stdout_truncated, stderr_truncated, stdout_bytes, stderr_bytes, compression, error_text)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+ON CONFLICT (run_id, attempt) DO UPDATE SET
+    exit_code = EXCLUDED.exit_code,
+    http_status = EXCLUDED.http_status,
+    stdout = EXCLUDED.stdout,
+    stderr = EXCLUDED.stderr,
+    stdout_truncated = EXCLUDED.stdout_truncated,
+    stderr_truncated = EXCLUDED.stderr_truncated,
+    stdout_bytes = EXCLUDED.stdout_bytes,
+    stderr_bytes = EXCLUDED.stderr_bytes,
+    compression = EXCLUDED.compression,
+    error_text = EXCLUDED.error_text,
+    created_at = now();
+`
+	_, err = s.DB.ExecContext(ctx, q, o.RunID, o.Attempt, o.ExitCode, o.HTTPStatus, outB, errB,
+		stdoutTrunc, stderrTrunc, len(o.Stdout), len(o.Stderr), compression, o.ErrorText)
+	return err
+}
+
+// ListRunOutputs returns the stored output of every attempt of a run, oldest first.
+func (s *Store) ListRunOutputs(ctx context.Context, runID string) ([]RunOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+
+	q := `
+SELECT run_id, attempt, exit_code, http_status, stdout, stderr,
+       stdout_truncated, stderr_truncated, stdout_bytes, stderr_bytes, compression, error_text, created_at
+FROM run_outputs
+WHERE run_id = $1
+ORDER BY attempt ASC;
+`
+	rows, err := s.DB.QueryContext(ctx, q, runID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []RunOutput
+	for rows.Next() {
+		var o RunOutput
+		var outB, errB []byte
+		var compression string
+		if err := rows.Scan(&o.RunID, &o.Attempt, &o.ExitCode, &o.HTTPStatus, &outB, &errB,
+			&o.StdoutTruncated, &o.StderrTruncated, &o.StdoutBytes, &o.StderrBytes, &compression, &o.ErrorText, &o.CreatedAt); err != nil {
+			return nil, err
+		}
+		if o.Stdout, err = decodeOutput(outB, compression); err != nil {
+			return nil, fmt.Errorf("decode stdout: %w", err)
+		}
+		if o.Stderr, err = decodeOutput(errB, compression); err != nil {
+			return nil, fmt.Errorf("decode stderr: %w", err)
+		}
+		out = append(out, o)
+	}
+	return out, rows.Err()
+}
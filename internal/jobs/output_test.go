@@ -0,0 +1,71 @@
+package jobs
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTruncateOutput_KeepsHeadAndTail(t *testing.T) {
+	s := strings.Repeat("a", 50) + strings.Repeat("b", 50)
+	out, truncated := TruncateOutput(s, 60)
+	if !truncated {
+		t.Fatalf("expected truncation")
+	}
+	if len(out) > 60 {
+		t.Fatalf("%d bytes, over the 60 allowed: %q", len(out), out)
+	}
+	if !strings.HasPrefix(out, strings.Repeat("a", 16)) || !strings.HasSuffix(out, strings.Repeat("b", 16)) {
+		t.Fatalf("head/tail not kept: %q", out)
+	}
+	if !strings.Contains(out, "[truncated 68 bytes]") {
+		t.Fatalf("missing marker: %q", out)
+	}
+	if got, tr := TruncateOutput("short", 20); tr || got != "short" {
+		t.Fatalf("short output changed: %q %v", got, tr)
+	}
+}
+
+func TestTruncateOutput_StaysWithinMaxOnRuneBoundaries(t *testing.T) {
+	s := strings.Repeat("héllo wörld ✓ ", 500)
+	for max := 1; max < 200; max++ {
+		out, truncated := TruncateOutput(s, max)
+		if !truncated {
+			t.Fatalf("max %d: expected truncation", max)
+		}
+		if len(out) > max {
+			t.Fatalf("max %d: got %d bytes", max, len(out))
+		}
+		if !utf8.ValidString(out) {
+			t.Fatalf("max %d: split a rune: %q", max, out)
+		}
+		if head, _, ok := strings.Cut(out, "\n...[truncated "); ok {
+			// the marker reports exactly what is missing
+			var n int
+			fmt.Sscanf(out[len(head):], "\n...[truncated %d bytes]", &n)
+			marker := fmt.Sprintf("\n...[truncated %d bytes]...\n", n)
+			if len(out)-len(marker)+n != len(s) {
+				t.Fatalf("max %d: marker says %d bytes dropped, %d were", max, n, len(s)-len(out)+len(marker))
+			}
+		}
+	}
+}
+
+func TestEncodeOutput_RoundTrip(t *testing.T) {
+	s := strings.Repeat("hello world\n", 100)
+	b, err := encodeOutput(s, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b) >= len(s) {
+		t.Fatalf("expected compression, %d >= %d", len(b), len(s))
+	}
+	got, err := decodeOutput(b, "gzip")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != s {
+		t.Fatalf("round trip mismatch")
+	}
+}
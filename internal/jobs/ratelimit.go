@@ -0,0 +1,53 @@
+package jobs
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// RateLimit allows Limit runs per Period across all workers. Up to Burst
+// runs may start back to back; the rest are spaced Period/Limit apart.
+type RateLimit struct {
+	Limit    int   `json:"limit"`
+	PeriodMS int64 `json:"period_ms"`
+	Burst    int   `json:"burst,omitempty"` // 0 = Limit
+}
+
+func (l RateLimit) Validate() error {
+	if l.Limit < 1 {
+		return fmt.Errorf("limit must be >= 1")
+	}
+	if l.PeriodMS < 1 {
+		return fmt.Errorf("period_ms must be >= 1")
+	}
+	if l.Burst < 0 {
+		return fmt.Errorf("burst must be >= 0")
+	}
+	return nil
+}
+
+func (l RateLimit) Period() time.Duration {
+	return time.Duration(l.PeriodMS) * time.Millisecond
+}
+
+// BurstOrLimit is the burst the limit allows.
+func (l RateLimit) BurstOrLimit() int {
+	if l.Burst > 0 {
+		return l.Burst
+	}
+	return l.Limit
+}
+
+// HostRateLimit limits the http runs of every job calling Host.
+type HostRateLimit struct {
+	Host      string    `json:"host"`
+	RateLimit RateLimit `json:"rate_limit"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NormalizeHost returns host the way limits are keyed: trimmed and lower
+// case.
+func NormalizeHost(host string) string {
+	return strings.ToLower(strings.TrimSpace(host))
+}
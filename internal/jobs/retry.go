@@ -0,0 +1,148 @@
+package jobs
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+type BackoffStrategy string
+
+const (
+	BackoffFixed       BackoffStrategy = "fixed"
+	BackoffLinear      BackoffStrategy = "linear"
+	BackoffExponential BackoffStrategy = "exponential"
+)
+
+type JitterMode string
+
+const (
+	JitterNone  JitterMode = "none"
+	JitterFull  JitterMode = "full"  // uniform in [0, d]
+	JitterEqual JitterMode = "equal" // d/2 + uniform in [0, d/2]
+)
+
+// RetryPolicy controls how often and how fast a failed run is retried.
+type RetryPolicy struct {
+	MaxAttempts int             `json:"max_attempts"`
+	Strategy    BackoffStrategy `json:"strategy"`
+	BaseDelayMS int64           `json:"base_delay_ms"`
+	MaxDelayMS  *int64          `json:"max_delay_ms,omitempty"` // nil = default, 0 = uncapped
+	Jitter      JitterMode      `json:"jitter"`
+}
+
+// DefaultRetryPolicy matches the worker's historical behaviour: 5 attempts,
+// exponential from 1s capped at 32s, no jitter.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 5,
+		Strategy:    BackoffExponential,
+		BaseDelayMS: 1000,
+		MaxDelayMS:  Millis(32000),
+		Jitter:      JitterNone,
+	}
+}
+
+// WithDefaults fills unset fields from def.
+func (p RetryPolicy) WithDefaults(def RetryPolicy) RetryPolicy {
+	if p.MaxAttempts == 0 {
+		p.MaxAttempts = def.MaxAttempts
+	}
+	if p.Strategy == "" {
+		p.Strategy = def.Strategy
+	}
+	if p.BaseDelayMS == 0 {
+		p.BaseDelayMS = def.BaseDelayMS
+	}
+	if p.MaxDelayMS == nil && def.MaxDelayMS != nil {
+		// the default cap never undercuts an explicit base delay
+		m := *def.MaxDelayMS
+		if m > 0 && m < p.BaseDelayMS {
+			m = p.BaseDelayMS
+		}
+		p.MaxDelayMS = &m
+	}
+	if p.Jitter == "" {
+		p.Jitter = def.Jitter
+	}
+	return p
+}
+
+func (p RetryPolicy) Validate() error {
+	if p.MaxAttempts < 1 {
+		return fmt.Errorf("max_attempts must be >= 1")
+	}
+	switch p.Strategy {
+	case BackoffFixed, BackoffLinear, BackoffExponential:
+	default:
+		return fmt.Errorf("strategy must be fixed, linear or exponential")
+	}
+	maxDelay := p.maxDelayMS()
+	if p.BaseDelayMS < 0 || maxDelay < 0 {
+		return fmt.Errorf("delays must be >= 0")
+	}
+	if maxDelay > 0 && maxDelay < p.BaseDelayMS {
+		return fmt.Errorf("max_delay_ms must be >= base_delay_ms")
+	}
+	switch p.Jitter {
+	case JitterNone, JitterFull, JitterEqual:
+	default:
+		return fmt.Errorf("jitter must be none, full or equal")
+	}
+	return nil
+}
+
+// Backoff returns the delay before retrying after the given number of failed
+// attempts (1-based), with jitter applied.
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	d := p.delay(attempt)
+	switch p.Jitter {
+	case JitterFull:
+		d = time.Duration(rand.Int64N(int64(d) + 1))
+	case JitterEqual:
+		half := d / 2
+		d = half + time.Duration(rand.Int64N(int64(d-half)+1))
+	}
+	return d
+}
+
+// delay is the un-jittered backoff.
+func (p RetryPolicy) delay(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	base := time.Duration(p.BaseDelayMS) * time.Millisecond
+	max := time.Duration(p.maxDelayMS()) * time.Millisecond
+
+	var d time.Duration
+	switch p.Strategy {
+	case BackoffFixed:
+		d = base
+	case BackoffLinear:
+		d = base * time.Duration(attempt)
+	default:
+		d = base
+		for i := 1; i < attempt && d < math.MaxInt64/2; i++ {
+			d *= 2
+			if max > 0 && d >= max {
+				break
+			}
+		}
+	}
+	if max > 0 && d > max {
+		d = max
+	}
+	return d
+}
+
+// maxDelayMS is the cap on the delay in ms; 0 = uncapped.
+func (p RetryPolicy) maxDelayMS() int64 {
+	if p.MaxDelayMS == nil {
+		return 0
+	}
+	return *p.MaxDelayMS
+}
+
+// Millis returns a pointer to ms, for optional millisecond fields.
+func Millis(ms int64) *int64 { return &ms }
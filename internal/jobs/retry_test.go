@@ -0,0 +1,91 @@
+package jobs
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetryPolicy_Delay(t *testing.T) {
+	cases := []struct {
+		name    string
+		p       RetryPolicy
+		attempt int
+		want    time.Duration
+	}{
+		{"fixed", RetryPolicy{Strategy: BackoffFixed, BaseDelayMS: 500}, 4, 500 * time.Millisecond},
+		{"linear", RetryPolicy{Strategy: BackoffLinear, BaseDelayMS: 500}, 3, 1500 * time.Millisecond},
+		{"exponential", RetryPolicy{Strategy: BackoffExponential, BaseDelayMS: 1000}, 4, 8 * time.Second},
+		{"capped", RetryPolicy{Strategy: BackoffExponential, BaseDelayMS: 1000, MaxDelayMS: Millis(5000)}, 10, 5 * time.Second},
+		{"huge attempt", RetryPolicy{Strategy: BackoffExponential, BaseDelayMS: 1000, MaxDelayMS: Millis(60000)}, 1000, time.Minute},
+	}
+	for _, c := range cases {
+		if got := c.p.delay(c.attempt); got != c.want {
+			t.Errorf("%s: delay(%d) = %v, want %v", c.name, c.attempt, got, c.want)
+		}
+	}
+}
+
+func TestRetryPolicy_Jitter(t *testing.T) {
+	full := RetryPolicy{Strategy: BackoffFixed, BaseDelayMS: 1000, Jitter: JitterFull}
+	equal := RetryPolicy{Strategy: BackoffFixed, BaseDelayMS: 1000, Jitter: JitterEqual}
+	for i := 0; i < 200; i++ {
+		if d := full.Backoff(1); d < 0 || d > time.Second {
+			t.Fatalf("full jitter out of range: %v", d)
+		}
+		if d := equal.Backoff(1); d < 500*time.Millisecond || d > time.Second {
+			t.Fatalf("equal jitter out of range: %v", d)
+		}
+	}
+}
+
+func TestRetryPolicy_Validate(t *testing.T) {
+	if err := DefaultRetryPolicy().Validate(); err != nil {
+		t.Fatalf("default policy invalid: %v", err)
+	}
+	p := RetryPolicy{MaxAttempts: 3}.WithDefaults(DefaultRetryPolicy())
+	if p.MaxAttempts != 3 || p.Strategy != BackoffExponential {
+		t.Fatalf("WithDefaults = %+v", p)
+	}
+	bad := []RetryPolicy{
+		{MaxAttempts: 0, Strategy: BackoffFixed, Jitter: JitterNone},
+		{MaxAttempts: 1, Strategy: "random", Jitter: JitterNone},
+		{MaxAttempts: 1, Strategy: BackoffFixed, BaseDelayMS: 10, MaxDelayMS: Millis(5), Jitter: JitterNone},
+		{MaxAttempts: 1, Strategy: BackoffFixed, Jitter: "some"},
+	}
+	for i, b := range bad {
+		if b.Validate() == nil {
+			t.Errorf("case %d: want error for %+v", i, b)
+		}
+	}
+}
+
+func TestRetryPolicy_MaxDelayDefaults(t *testing.T) {
+	def := DefaultRetryPolicy()
+
+	// unset takes the default cap
+	p := RetryPolicy{MaxAttempts: 3, BaseDelayMS: 1000}.WithDefaults(def)
+	if p.MaxDelayMS == nil || *p.MaxDelayMS != 32000 {
+		t.Fatalf("unset max = %v, want 32000", p.MaxDelayMS)
+	}
+
+	// 0 stays uncapped
+	p = RetryPolicy{MaxAttempts: 20, BaseDelayMS: 1000, MaxDelayMS: Millis(0)}.WithDefaults(def)
+	if p.MaxDelayMS == nil || *p.MaxDelayMS != 0 {
+		t.Fatalf("explicit 0 became %v", p.MaxDelayMS)
+	}
+	if err := p.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if got := p.delay(10); got != 512*time.Second {
+		t.Fatalf("uncapped delay(10) = %v", got)
+	}
+
+	// a base above the default cap is not rejected
+	p = RetryPolicy{MaxAttempts: 3, Strategy: BackoffFixed, BaseDelayMS: 120000}.WithDefaults(def)
+	if err := p.Validate(); err != nil {
+		t.Fatalf("slow retry rejected: %v", err)
+	}
+	if got := p.delay(2); got != 2*time.Minute {
+		t.Fatalf("delay(2) = %v", got)
+	}
+}
@@ -6,15 +6,28 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	_ "github.com/jackc/pgx/v5/stdlib"
 )
 
 var (
-	ErrNotFound = errors.New("not found")
+	ErrNotFound    = errors.New("not found")
+	ErrRunFinished = errors.New("run already finished")
 )
 
+// queryer is satisfied by both *sql.DB and *sql.Tx.
+type queryer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
 type Store struct {
 	DB        *sql.DB
 	DefaultTO time.Duration // default timeout per query
@@ -26,12 +39,60 @@ func NewStore(db *sql.DB) *Store {
 
 /* ===================== Jobs ===================== */
 
+const jobColumns = `id, name, type, handler, args, enabled, retry_policy, max_concurrency, priority, queue, rate_limit, created_at, updated_at`
+
+// scanJob reads a row selected with jobColumns.
+func scanJob(row scanner, j *Job) error {
+	var argsRaw, retryRaw, rateRaw []byte
+	if err := row.Scan(&j.ID, &j.Name, &j.Type, &j.Handler, &argsRaw, &j.Enabled, &retryRaw, &j.MaxConcurrency, &j.Priority, &j.Queue, &rateRaw, &j.CreatedAt, &j.UpdatedAt); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(argsRaw, &j.Args)
+	if len(retryRaw) > 0 {
+		var rp RetryPolicy
+		if err := json.Unmarshal(retryRaw, &rp); err == nil {
+			j.RetryPolicy = &rp
+		}
+	}
+	if len(rateRaw) > 0 {
+		var rl RateLimit
+		if err := json.Unmarshal(rateRaw, &rl); err == nil {
+			j.RateLimit = &rl
+		}
+	}
+	return nil
+}
+
+// retryPolicyArg encodes an optional policy for a JSONB column (NULL if nil).
+func retryPolicyArg(p *RetryPolicy) any {
+	if p == nil {
+		return nil
+	}
+	b, _ := json.Marshal(p)
+	return string(b)
+}
+
+// rateLimitArg encodes an optional rate limit for a JSONB column (NULL if
+// nil or without a limit).
+func rateLimitArg(l *RateLimit) any {
+	if l == nil || l.Limit == 0 {
+		return nil
+	}
+	b, _ := json.Marshal(l)
+	return string(b)
+}
+
 type CreateJobParams struct {
-	Name    string
-	Type    string
-	Handler string // "shell" | "http"
-	Args    map[string]any
-	Enabled bool
+	Name           string
+	Type           string
+	Handler        string // registered handler name
+	Args           map[string]any
+	Enabled        bool
+	RetryPolicy    *RetryPolicy // nil = worker default
+	MaxConcurrency int          // 0 = unlimited
+	Priority       Priority     // "" = normal
+	Queue          string       // "" = default
+	RateLimit      *RateLimit   // nil = unlimited
 }
 
 func (s *Store) CreateJob(ctx context.Context, p CreateJobParams) (*Job, error) {
@@ -39,21 +100,70 @@ func (s *Store) CreateJob(ctx context.Context, p CreateJobParams) (*Job, error)
 	defer cancel()
 
 	argsJSON, _ := json.Marshal(p.Args)
+	if p.Priority == "" {
+		p.Priority = PriorityNormal
+	}
+	if p.Queue == "" {
+		p.Queue = "default"
+	}
 	q := `
-INSERT INTO jobs (name, type, handler, args, enabled)
-VALUES ($1, $2, $3, $4::jsonb, $5)
-RETURNING id, name, type, handler, args, enabled, created_at, updated_at;
-`
+INSERT INTO jobs (name, type, handler, args, enabled, retry_policy, max_concurrency, priority, queue, rate_limit)
+VALUES ($1, $2, $3, $4::jsonb, $5, $6::jsonb, $7, $8, $9, $10::jsonb)
+RETURNING ` + jobColumns
 	var j Job
-	var argsRaw []byte
-	if err := s.DB.QueryRowContext(ctx, q, p.Name, p.Type, p.Handler, string(argsJSON), p.Enabled).
-		Scan(&j.ID, &j.Name, &j.Type, &j.Handler, &argsRaw, &j.Enabled, &j.CreatedAt, &j.UpdatedAt); err != nil {
+	if err := scanJob(s.DB.QueryRowContext(ctx, q, p.Name, p.Type, p.Handler, string(argsJSON), p.Enabled,
+		retryPolicyArg(p.RetryPolicy), p.MaxConcurrency, string(p.Priority), p.Queue, rateLimitArg(p.RateLimit)), &j); err != nil {
 		return nil, err
 	}
-	_ = json.Unmarshal(argsRaw, &j.Args)
 	return &j, nil
 }
 
+func (s *Store) GetJob(ctx context.Context, id string) (*Job, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	return getJob(ctx, s.DB, id)
+}
+
+// GetJobTx loads the job inside the caller's transaction.
+func (s *Store) GetJobTx(ctx context.Context, tx *sql.Tx, id string) (*Job, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	return getJob(ctx, tx, id)
+}
+
+func getJob(ctx context.Context, db queryer, id string) (*Job, error) {
+	var j Job
+	if err := scanJob(db.QueryRowContext(ctx, `SELECT `+jobColumns+` FROM jobs WHERE id = $1`, id), &j); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &j, nil
+}
+
+// GetJobsTx loads the jobs with the given ids inside the caller's
+// transaction, keyed by id. Missing ids are left out.
+func (s *Store) GetJobsTx(ctx context.Context, tx *sql.Tx, ids []string) (map[string]*Job, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	rows, err := tx.QueryContext(ctx, `SELECT `+jobColumns+` FROM jobs WHERE id = ANY($1::text[]::uuid[])`, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make(map[string]*Job, len(ids))
+	for rows.Next() {
+		var j Job
+		if err := scanJob(rows, &j); err != nil {
+			return nil, err
+		}
+		out[j.ID] = &j
+	}
+	return out, rows.Err()
+}
+
 type ListJobsParams struct {
 	Limit  int
 	Offset int
@@ -66,12 +176,10 @@ func (s *Store) ListJobs(ctx context.Context, p ListJobsParams) ([]Job, error) {
 	if p.Limit <= 0 || p.Limit > 200 {
 		p.Limit = 50
 	}
-	q := `
-SELECT id, name, type, handler, args, enabled, created_at, updated_at
+	q := `SELECT ` + jobColumns + `
 FROM jobs
 ORDER BY created_at DESC
-LIMIT $1 OFFSET $2;
-`
+LIMIT $1 OFFSET $2;`
 	rows, err := s.DB.QueryContext(ctx, q, p.Limit, p.Offset)
 	if err != nil {
 		return nil, err
@@ -81,21 +189,24 @@ LIMIT $1 OFFSET $2;
 	var out []Job
 	for rows.Next() {
 		var j Job
-		var argsRaw []byte
-		if err := rows.Scan(&j.ID, &j.Name, &j.Type, &j.Handler, &argsRaw, &j.Enabled, &j.CreatedAt, &j.UpdatedAt); err != nil {
+		if err := scanJob(rows, &j); err != nil {
 			return nil, err
 		}
-		_ = json.Unmarshal(argsRaw, &j.Args)
 		out = append(out, j)
 	}
 	return out, rows.Err()
 }
 
 type UpdateJobParams struct {
-	ID      string
-	Name    *string
-	Args    *map[string]any
-	Enabled *bool
+	ID             string
+	Name           *string
+	Args           *map[string]any
+	Enabled        *bool
+	RetryPolicy    *RetryPolicy
+	MaxConcurrency *int
+	Priority       *Priority
+	Queue          *string
+	RateLimit      *RateLimit // a zero Limit removes the limit
 }
 
 func (s *Store) UpdateJob(ctx context.Context, p UpdateJobParams) (*Job, error) {
@@ -123,6 +234,31 @@ func (s *Store) UpdateJob(ctx context.Context, p UpdateJobParams) (*Job, error)
 		args = append(args, *p.Enabled)
 		i++
 	}
+	if p.RetryPolicy != nil {
+		set += fmt.Sprintf("retry_policy = $%d::jsonb,", i)
+		args = append(args, retryPolicyArg(p.RetryPolicy))
+		i++
+	}
+	if p.MaxConcurrency != nil {
+		set += fmt.Sprintf("max_concurrency = $%d,", i)
+		args = append(args, *p.MaxConcurrency)
+		i++
+	}
+	if p.Priority != nil {
+		set += fmt.Sprintf("priority = $%d,", i)
+		args = append(args, string(*p.Priority))
+		i++
+	}
+	if p.Queue != nil {
+		set += fmt.Sprintf("queue = $%d,", i)
+		args = append(args, *p.Queue)
+		i++
+	}
+	if p.RateLimit != nil {
+		set += fmt.Sprintf("rate_limit = $%d::jsonb,", i)
+		args = append(args, rateLimitArg(p.RateLimit))
+		i++
+	}
 	if set == "" {
 		return nil, errors.New("no fields to update")
 	}
@@ -130,19 +266,16 @@ func (s *Store) UpdateJob(ctx context.Context, p UpdateJobParams) (*Job, error)
 	q := fmt.Sprintf(`
 UPDATE jobs SET %s
 WHERE id = $%d
-RETURNING id, name, type, handler, args, enabled, created_at, updated_at;`, set, i)
+RETURNING %s;`, set, i, jobColumns)
 	args = append(args, p.ID)
 
 	var j Job
-	var argsRaw []byte
-	if err := s.DB.QueryRowContext(ctx, q, args...).
-		Scan(&j.ID, &j.Name, &j.Type, &j.Handler, &argsRaw, &j.Enabled, &j.CreatedAt, &j.UpdatedAt); err != nil {
+	if err := scanJob(s.DB.QueryRowContext(ctx, q, args...), &j); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, ErrNotFound
 		}
 		return nil, err
 	}
-	_ = json.Unmarshal(argsRaw, &j.Args)
 	return &j, nil
 }
 
@@ -162,13 +295,54 @@ func (s *Store) DisableJob(ctx context.Context, id string) error {
 
 /* ===================== Schedules ===================== */
 
+const scheduleColumns = `id, job_id, workflow_id, cron_expr, fixed_interval_seconds, next_run_at, timezone, last_enqueued_at, enabled,
+misfire_policy, misfire_grace_seconds, max_catchup, interval_mode, interval_anchor,
+start_at, end_at, max_runs, paused_until, fire_count,
+COALESCE((SELECT string_agg(calendar_id::text, ',' ORDER BY calendar_id)
+    FROM schedule_calendars WHERE schedule_id = schedules.id), '')`
+
+// scanSchedule reads a row selected with scheduleColumns.
+func scanSchedule(row scanner, sc *Schedule) error {
+	var jobID sql.NullString
+	var calendars string
+	if err := row.Scan(&sc.ID, &jobID, &sc.WorkflowID, &sc.CronExpr, &sc.FixedIntervalSeconds, &sc.NextRunAt, &sc.Timezone, &sc.LastEnqueuedAt, &sc.Enabled,
+		&sc.MisfirePolicy, &sc.MisfireGraceSeconds, &sc.MaxCatchup, &sc.IntervalMode, &sc.IntervalAnchor,
+		&sc.StartAt, &sc.EndAt, &sc.MaxRuns, &sc.PausedUntil, &sc.FireCount, &calendars); err != nil {
+		return err
+	}
+	sc.JobID = jobID.String
+	sc.CalendarIDs = nil
+	if calendars != "" {
+		sc.CalendarIDs = strings.Split(calendars, ",")
+	}
+	return nil
+}
+
+// nullIfEmpty maps "" to SQL NULL.
+func nullIfEmpty(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
 type CreateScheduleParams struct {
-	JobID                string
+	JobID                string  // set exactly one of JobID
+	WorkflowID           *string // and WorkflowID
 	CronExpr             *string
 	FixedIntervalSeconds *int
 	NextRunAt            time.Time
 	Timezone             string
 	Enabled              bool
+	MisfirePolicy        MisfirePolicy
+	MisfireGraceSeconds  int
+	MaxCatchup           int
+	IntervalMode         IntervalMode // "" = elapsed
+	CalendarIDs          []string
+	StartAt              *time.Time
+	EndAt                *time.Time
+	MaxRuns              *int
+	PausedUntil          *time.Time
 }
 
 func (s *Store) CreateSchedule(ctx context.Context, p CreateScheduleParams) (*Schedule, error) {
@@ -176,13 +350,47 @@ func (s *Store) CreateSchedule(ctx context.Context, p CreateScheduleParams) (*Sc
 	defer cancel()
 
 	q := `
-INSERT INTO schedules (job_id, cron_expr, fixed_interval_seconds, next_run_at, timezone, enabled)
-VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING id, job_id, cron_expr, fixed_interval_seconds, next_run_at, timezone, last_enqueued_at, enabled;
-`
+INSERT INTO schedules (job_id, workflow_id, cron_expr, fixed_interval_seconds, next_run_at, timezone, enabled,
+    misfire_policy, misfire_grace_seconds, max_catchup, interval_mode, interval_anchor,
+    start_at, end_at, max_runs, paused_until)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $5, $12, $13, $14, $15)
+RETURNING ` + scheduleColumns
+	if p.IntervalMode == "" {
+		p.IntervalMode = IntervalElapsed
+	}
+
+	tx, err := s.DB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var sc Schedule
+	if err := scanSchedule(tx.QueryRowContext(ctx, q, nullIfEmpty(p.JobID), p.WorkflowID, p.CronExpr, p.FixedIntervalSeconds, p.NextRunAt, p.Timezone, p.Enabled,
+		string(p.MisfirePolicy), p.MisfireGraceSeconds, p.MaxCatchup, string(p.IntervalMode),
+		p.StartAt, p.EndAt, p.MaxRuns, p.PausedUntil), &sc); err != nil {
+		return nil, err
+	}
+	if len(p.CalendarIDs) > 0 {
+		if err := setScheduleCalendars(ctx, tx, sc.ID, p.CalendarIDs); err != nil {
+			return nil, err
+		}
+		sc.CalendarIDs = p.CalendarIDs
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &sc, nil
+}
+
+func (s *Store) GetSchedule(ctx context.Context, id string) (*Schedule, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
 	var sc Schedule
-	if err := s.DB.QueryRowContext(ctx, q, p.JobID, p.CronExpr, p.FixedIntervalSeconds, p.NextRunAt, p.Timezone, p.Enabled).
-		Scan(&sc.ID, &sc.JobID, &sc.CronExpr, &sc.FixedIntervalSeconds, &sc.NextRunAt, &sc.Timezone, &sc.LastEnqueuedAt, &sc.Enabled); err != nil {
+	if err := scanSchedule(s.DB.QueryRowContext(ctx, `SELECT `+scheduleColumns+` FROM schedules WHERE id = $1`, id), &sc); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
 		return nil, err
 	}
 	return &sc, nil
@@ -200,8 +408,7 @@ func (s *Store) ListSchedules(ctx context.Context, p ListSchedulesParams) ([]Sch
 	if p.Limit <= 0 || p.Limit > 200 {
 		p.Limit = 50
 	}
-	q := `
-SELECT id, job_id, cron_expr, fixed_interval_seconds, next_run_at, timezone, last_enqueued_at, enabled
+	q := `SELECT ` + scheduleColumns + `
 FROM schedules
 ORDER BY next_run_at ASC
 LIMIT $1 OFFSET $2;
@@ -215,7 +422,36 @@ LIMIT $1 OFFSET $2;
 	var out []Schedule
 	for rows.Next() {
 		var sc Schedule
-		if err := rows.Scan(&sc.ID, &sc.JobID, &sc.CronExpr, &sc.FixedIntervalSeconds, &sc.NextRunAt, &sc.Timezone, &sc.LastEnqueuedAt, &sc.Enabled); err != nil {
+		if err := scanSchedule(rows, &sc); err != nil {
+			return nil, err
+		}
+		out = append(out, sc)
+	}
+	return out, rows.Err()
+}
+
+// ClaimDueSchedulesTx locks up to limit due schedules, earliest first, that
+// no other transaction holds. Scanners that claim this way share the due
+// schedules without a leader: a locked row is skipped, and by the time its
+// lock is released next_run_at has moved on.
+func (s *Store) ClaimDueSchedulesTx(ctx context.Context, tx *sql.Tx, now time.Time, limit int) ([]Schedule, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	rows, err := tx.QueryContext(ctx, `SELECT `+scheduleColumns+`
+FROM schedules
+WHERE enabled = true AND next_run_at <= $1
+ORDER BY next_run_at ASC
+LIMIT $2
+FOR UPDATE SKIP LOCKED`, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []Schedule
+	for rows.Next() {
+		var sc Schedule
+		if err := scanSchedule(rows, &sc); err != nil {
 			return nil, err
 		}
 		out = append(out, sc)
@@ -223,6 +459,59 @@ LIMIT $1 OFFSET $2;
 	return out, rows.Err()
 }
 
+// ScheduleAdvance moves a fired schedule to its next run.
+type ScheduleAdvance struct {
+	ID             string
+	NextRunAt      time.Time
+	LastEnqueuedAt *time.Time
+	Fired          int  // added to fire_count
+	Done           bool // past end_at or max_runs: disable
+}
+
+// AdvanceSchedulesTx updates next_run_at, last_enqueued_at and fire_count of
+// many schedules in one statement, disables the finished ones and clears
+// pauses that are over at now.
+func (s *Store) AdvanceSchedulesTx(ctx context.Context, tx *sql.Tx, now time.Time, advances []ScheduleAdvance) error {
+	if len(advances) == 0 {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	var (
+		ids   = make([]string, len(advances))
+		next  = make([]time.Time, len(advances))
+		last  = make([]*time.Time, len(advances))
+		fired = make([]int32, len(advances))
+		done  = make([]bool, len(advances))
+	)
+	for i, a := range advances {
+		ids[i], next[i], last[i], fired[i], done[i] = a.ID, a.NextRunAt, a.LastEnqueuedAt, int32(a.Fired), a.Done
+	}
+	_, err := tx.ExecContext(ctx, `
+UPDATE schedules s
+SET next_run_at = u.next, last_enqueued_at = u.last, fire_count = s.fire_count + u.fired,
+    enabled = s.enabled AND NOT u.done,
+    paused_until = CASE WHEN s.paused_until <= $6 THEN NULL ELSE s.paused_until END
+FROM unnest($1::text[], $2::timestamptz[], $3::timestamptz[], $4::int[], $5::bool[]) AS u(id, next, last, fired, done)
+WHERE s.id = u.id::uuid`, ids, next, last, fired, done, now)
+	return err
+}
+
+// NextDueAt returns the earliest next_run_at of any enabled schedule or
+// scheduled_for of any pending delayed run, or nil if there is none.
+func (s *Store) NextDueAt(ctx context.Context) (*time.Time, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	var t *time.Time
+	if err := s.DB.QueryRowContext(ctx, `
+SELECT LEAST(
+    (SELECT MIN(next_run_at) FROM schedules WHERE enabled = true),
+    (SELECT MIN(scheduled_for) FROM job_runs WHERE scheduled_for IS NOT NULL AND released_at IS NULL AND status = 'queued'))`).Scan(&t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
 type UpdateScheduleParams struct {
 	ID                   string
 	CronExpr             *string
@@ -231,6 +520,16 @@ type UpdateScheduleParams struct {
 	Timezone             *string
 	Enabled              *bool
 	LastEnqueuedAt       *time.Time
+	MisfirePolicy        *MisfirePolicy
+	MisfireGraceSeconds  *int
+	MaxCatchup           *int
+	IntervalMode         *IntervalMode
+	CalendarIDs          *[]string // replaces the schedule's calendars
+	// bounds: nil leaves them, an invalid value clears them
+	StartAt     *sql.NullTime
+	EndAt       *sql.NullTime
+	MaxRuns     *sql.NullInt32
+	PausedUntil *sql.NullTime
 }
 
 func (s *Store) UpdateSchedule(ctx context.Context, p UpdateScheduleParams) (*Schedule, error) {
@@ -241,18 +540,20 @@ func (s *Store) UpdateSchedule(ctx context.Context, p UpdateScheduleParams) (*Sc
 	args := []any{}
 	i := 1
 
+	// a schedule has one kind, so setting one clears the other
 	if p.CronExpr != nil {
-		set += fmt.Sprintf("cron_expr = $%d,", i)
+		set += fmt.Sprintf("cron_expr = $%d, fixed_interval_seconds = NULL,", i)
 		args = append(args, *p.CronExpr)
 		i++
 	}
 	if p.FixedIntervalSeconds != nil {
-		set += fmt.Sprintf("fixed_interval_seconds = $%d,", i)
+		set += fmt.Sprintf("fixed_interval_seconds = $%d, cron_expr = NULL,", i)
 		args = append(args, *p.FixedIntervalSeconds)
 		i++
 	}
 	if p.NextRunAt != nil {
-		set += fmt.Sprintf("next_run_at = $%d,", i)
+		// a new start also re-anchors wall_clock intervals
+		set += fmt.Sprintf("next_run_at = $%d, interval_anchor = $%d,", i, i)
 		args = append(args, *p.NextRunAt)
 		i++
 	}
@@ -271,25 +572,93 @@ func (s *Store) UpdateSchedule(ctx context.Context, p UpdateScheduleParams) (*Sc
 		args = append(args, *p.LastEnqueuedAt)
 		i++
 	}
+	if p.MisfirePolicy != nil {
+		set += fmt.Sprintf("misfire_policy = $%d,", i)
+		args = append(args, string(*p.MisfirePolicy))
+		i++
+	}
+	if p.MisfireGraceSeconds != nil {
+		set += fmt.Sprintf("misfire_grace_seconds = $%d,", i)
+		args = append(args, *p.MisfireGraceSeconds)
+		i++
+	}
+	if p.MaxCatchup != nil {
+		set += fmt.Sprintf("max_catchup = $%d,", i)
+		args = append(args, *p.MaxCatchup)
+		i++
+	}
+	if p.IntervalMode != nil {
+		set += fmt.Sprintf("interval_mode = $%d,", i)
+		args = append(args, string(*p.IntervalMode))
+		i++
+	}
+	if p.StartAt != nil {
+		set += fmt.Sprintf("start_at = $%d,", i)
+		args = append(args, *p.StartAt)
+		i++
+	}
+	if p.EndAt != nil {
+		set += fmt.Sprintf("end_at = $%d,", i)
+		args = append(args, *p.EndAt)
+		i++
+	}
+	if p.MaxRuns != nil {
+		set += fmt.Sprintf("max_runs = $%d,", i)
+		args = append(args, *p.MaxRuns)
+		i++
+	}
+	if p.PausedUntil != nil {
+		set += fmt.Sprintf("paused_until = $%d,", i)
+		args = append(args, *p.PausedUntil)
+		i++
+	}
 
-	if set == "" {
+	if set == "" && p.CalendarIDs == nil {
 		return nil, errors.New("no fields to update")
 	}
 
-	q := fmt.Sprintf(`
+	tx, err := s.DB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if p.CalendarIDs != nil {
+		var found bool
+		if err := tx.QueryRowContext(ctx, `
+SELECT EXISTS (SELECT 1 FROM schedules WHERE id = $1 FOR UPDATE)`, p.ID).Scan(&found); err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, ErrNotFound
+		}
+		if err := setScheduleCalendars(ctx, tx, p.ID, *p.CalendarIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	// runs after the calendar change, so the returned row includes it
+	q := fmt.Sprintf(`SELECT %s FROM schedules WHERE id = $1`, scheduleColumns)
+	if set != "" {
+		q = fmt.Sprintf(`
 UPDATE schedules SET %s
 WHERE id = $%d
-RETURNING id, job_id, cron_expr, fixed_interval_seconds, next_run_at, timezone, last_enqueued_at, enabled;`, set[:len(set)-1], i)
-	args = append(args, p.ID)
+RETURNING %s;`, set[:len(set)-1], i, scheduleColumns)
+		args = append(args, p.ID)
+	} else {
+		args = []any{p.ID}
+	}
 
 	var sc Schedule
-	if err := s.DB.QueryRowContext(ctx, q, args...).
-		Scan(&sc.ID, &sc.JobID, &sc.CronExpr, &sc.FixedIntervalSeconds, &sc.NextRunAt, &sc.Timezone, &sc.LastEnqueuedAt, &sc.Enabled); err != nil {
+	if err := scanSchedule(tx.QueryRowContext(ctx, q, args...), &sc); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, ErrNotFound
 		}
 		return nil, err
 	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
 	return &sc, nil
 }
 
@@ -306,8 +675,54 @@ func (s *Store) DeleteSchedule(ctx context.Context, id string) error {
 	return nil
 }
 
+// InsertMisfireTx records missed occurrences in the scan's transaction.
+func (s *Store) InsertMisfireTx(ctx context.Context, tx *sql.Tx, m ScheduleMisfire) error {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	_, err := tx.ExecContext(ctx, `
+INSERT INTO schedule_misfires (schedule_id, policy, missed_count, first_missed_at, last_missed_at)
+VALUES ($1, $2, $3, $4, $5)`, m.ScheduleID, string(m.Policy), m.MissedCount, m.FirstMissedAt, m.LastMissedAt)
+	return err
+}
+
+// ListMisfires returns a schedule's misfire records, newest first.
+func (s *Store) ListMisfires(ctx context.Context, scheduleID string, limit int) ([]ScheduleMisfire, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	if limit <= 0 || limit > 200 {
+		limit = 50
+	}
+	rows, err := s.DB.QueryContext(ctx, `
+SELECT id, schedule_id, policy, missed_count, first_missed_at, last_missed_at, detected_at
+FROM schedule_misfires
+WHERE schedule_id = $1
+ORDER BY detected_at DESC
+LIMIT $2;`, scheduleID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []ScheduleMisfire
+	for rows.Next() {
+		var m ScheduleMisfire
+		if err := rows.Scan(&m.ID, &m.ScheduleID, &m.Policy, &m.MissedCount, &m.FirstMissedAt, &m.LastMissedAt, &m.DetectedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, m)
+	}
+	return out, rows.Err()
+}
+
 /* ===================== Job Runs ===================== */
 
+const runColumns = `id, job_id, run_id, started_at, finished_at, status, attempts, error_text, error_class, worker_id, idempotency_key, scheduled_for, throttle_count, throttled_at, throttled_by`
+
+// scanRun reads a row selected with runColumns.
+func scanRun(row scanner, r *JobRun) error {
+	return row.Scan(&r.ID, &r.JobID, &r.RunID, &r.StartedAt, &r.FinishedAt, &r.Status, &r.Attempts, &r.ErrorText, &r.ErrorClass, &r.WorkerID, &r.IdempotencyKey, &r.ScheduledFor, &r.ThrottleCount, &r.ThrottledAt, &r.ThrottledBy)
+}
+
 type InsertRunParams struct {
 	JobID          string
 	RunID          string // UUID
@@ -319,14 +734,82 @@ type InsertRunParams struct {
 func (s *Store) InsertRun(ctx context.Context, p InsertRunParams) (*JobRun, error) {
 	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
 	defer cancel()
+	return insertRun(ctx, s.DB, p)
+}
+
+// InsertRunTx inserts the run row inside the caller's transaction, so it
+// commits together with the outbox message that announces it.
+func (s *Store) InsertRunTx(ctx context.Context, tx *sql.Tx, p InsertRunParams) (*JobRun, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	return insertRun(ctx, tx, p)
+}
+
+func insertRun(ctx context.Context, db queryer, p InsertRunParams) (*JobRun, error) {
 	q := `
 INSERT INTO job_runs (job_id, run_id, status, worker_id, idempotency_key)
 VALUES ($1, $2, $3, $4, $5)
-RETURNING id, job_id, run_id, started_at, finished_at, status, attempts, error_text, worker_id, idempotency_key;
-`
+RETURNING ` + runColumns
+	var r JobRun
+	if err := scanRun(db.QueryRowContext(ctx, q, p.JobID, p.RunID, string(p.Status), p.WorkerID, p.IdempotencyKey), &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// InsertRunsTx inserts many run rows in one statement inside the caller's
+// transaction and returns the run IDs it inserted. Runs whose idempotency
+// key already exists (e.g. two schedules of a job due at the same instant)
+// are skipped rather than failing the batch.
+func (s *Store) InsertRunsTx(ctx context.Context, tx *sql.Tx, runs []InsertRunParams) (map[string]bool, error) {
+	if len(runs) == 0 {
+		return nil, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	var (
+		jobIDs   = make([]string, len(runs))
+		runIDs   = make([]string, len(runs))
+		statuses = make([]string, len(runs))
+		workers  = make([]*string, len(runs))
+		keys     = make([]string, len(runs))
+	)
+	for i, p := range runs {
+		jobIDs[i], runIDs[i], statuses[i], workers[i], keys[i] = p.JobID, p.RunID, string(p.Status), p.WorkerID, p.IdempotencyKey
+	}
+	rows, err := tx.QueryContext(ctx, `
+INSERT INTO job_runs (job_id, run_id, status, worker_id, idempotency_key)
+SELECT job_id::uuid, run_id::uuid, status, worker_id, idempotency_key
+FROM unnest($1::text[], $2::text[], $3::text[], $4::text[], $5::text[]) AS r(job_id, run_id, status, worker_id, idempotency_key)
+ON CONFLICT (idempotency_key) DO NOTHING
+RETURNING run_id`,
+		jobIDs, runIDs, statuses, workers, keys)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	inserted := make(map[string]bool, len(runs))
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		inserted[id] = true
+	}
+	return inserted, rows.Err()
+}
+
+func (s *Store) GetRun(ctx context.Context, runID string) (*JobRun, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	q := `SELECT ` + runColumns + `
+FROM job_runs
+WHERE run_id = $1;`
 	var r JobRun
-	if err := s.DB.QueryRowContext(ctx, q, p.JobID, p.RunID, string(p.Status), p.WorkerID, p.IdempotencyKey).
-		Scan(&r.ID, &r.JobID, &r.RunID, &r.StartedAt, &r.FinishedAt, &r.Status, &r.Attempts, &r.ErrorText, &r.WorkerID, &r.IdempotencyKey); err != nil {
+	if err := scanRun(s.DB.QueryRowContext(ctx, q, runID), &r); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
 		return nil, err
 	}
 	return &r, nil
@@ -336,6 +819,7 @@ type UpdateRunStatusParams struct {
 	RunID      string
 	Status     JobRunStatus
 	ErrorText  *string
+	ErrorClass *string
 	WorkerID   *string
 	FinishedAt *time.Time
 	Attempts   *int
@@ -354,6 +838,11 @@ func (s *Store) UpdateRunStatus(ctx context.Context, p UpdateRunStatusParams) (*
 		args = append(args, *p.ErrorText)
 		i++
 	}
+	if p.ErrorClass != nil {
+		set += fmt.Sprintf(", error_class = $%d", i)
+		args = append(args, *p.ErrorClass)
+		i++
+	}
 	if p.WorkerID != nil {
 		set += fmt.Sprintf(", worker_id = $%d", i)
 		args = append(args, *p.WorkerID)
@@ -370,16 +859,16 @@ func (s *Store) UpdateRunStatus(ctx context.Context, p UpdateRunStatusParams) (*
 		i++
 	}
 
+	// Canceled runs stay canceled; the update then reports ErrNotFound.
 	q := fmt.Sprintf(`
 UPDATE job_runs
 SET %s
-WHERE run_id = $%d
-RETURNING id, job_id, run_id, started_at, finished_at, status, attempts, error_text, worker_id, idempotency_key;`, set, i)
+WHERE run_id = $%d AND status <> 'canceled'
+RETURNING %s;`, set, i, runColumns)
 	args = append(args, p.RunID)
 
 	var r JobRun
-	if err := s.DB.QueryRowContext(ctx, q, args...).
-		Scan(&r.ID, &r.JobID, &r.RunID, &r.StartedAt, &r.FinishedAt, &r.Status, &r.Attempts, &r.ErrorText, &r.WorkerID, &r.IdempotencyKey); err != nil {
+	if err := scanRun(s.DB.QueryRowContext(ctx, q, args...), &r); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, ErrNotFound
 		}
@@ -388,14 +877,25 @@ RETURNING id, job_id, run_id, started_at, finished_at, status, attempts, error_t
 	return &r, nil
 }
 
+// RecordThrottle notes on the run that the rate limit by sent it back to
+// wait.
+func (s *Store) RecordThrottle(ctx context.Context, runID, by string) error {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	_, err := s.DB.ExecContext(ctx, `
+UPDATE job_runs
+SET throttle_count = throttle_count + 1, throttled_at = now(), throttled_by = $2
+WHERE run_id = $1`, runID, by)
+	return err
+}
+
 func (s *Store) ListRunsForJob(ctx context.Context, jobID string, limit int) ([]JobRun, error) {
 	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
 	defer cancel()
 	if limit <= 0 || limit > 200 {
 		limit = 50
 	}
-	q := `
-SELECT id, job_id, run_id, started_at, finished_at, status, attempts, error_text, worker_id, idempotency_key
+	q := `SELECT ` + runColumns + `
 FROM job_runs
 WHERE job_id = $1
 ORDER BY started_at DESC
@@ -410,10 +910,238 @@ LIMIT $2;
 	var out []JobRun
 	for rows.Next() {
 		var r JobRun
-		if err := rows.Scan(&r.ID, &r.JobID, &r.RunID, &r.StartedAt, &r.FinishedAt, &r.Status, &r.Attempts, &r.ErrorText, &r.WorkerID, &r.IdempotencyKey); err != nil {
+		if err := scanRun(rows, &r); err != nil {
+			return nil, err
+		}
+		out = append(out, r)
+	}
+	return out, rows.Err()
+}
+
+// CancelRun marks an unfinished run canceled. It returns ErrRunFinished (with
+// the run) if the run already reached a terminal status.
+func (s *Store) CancelRun(ctx context.Context, runID string) (*JobRun, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	q := `
+UPDATE job_runs
+SET status = 'canceled', finished_at = now()
+WHERE run_id = $1 AND status IN ('queued', 'running', 'retried')
+RETURNING ` + runColumns
+	var r JobRun
+	err := scanRun(s.DB.QueryRowContext(ctx, q, runID), &r)
+	if errors.Is(err, sql.ErrNoRows) {
+		cur, err := s.GetRun(ctx, runID)
+		if err != nil {
+			return nil, err
+		}
+		return cur, ErrRunFinished
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// CancelRunsForJob cancels every unfinished run of a job and returns them.
+func (s *Store) CancelRunsForJob(ctx context.Context, jobID string) ([]JobRun, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	rows, err := s.DB.QueryContext(ctx, `
+UPDATE job_runs
+SET status = 'canceled', finished_at = now()
+WHERE job_id = $1 AND status IN ('queued', 'running', 'retried')
+RETURNING `+runColumns, jobID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []JobRun
+	for rows.Next() {
+		var r JobRun
+		if err := scanRun(rows, &r); err != nil {
+			return nil, err
+		}
+		out = append(out, r)
+	}
+	return out, rows.Err()
+}
+
+/* ===================== Delayed runs ===================== */
+
+type InsertPendingRunParams struct {
+	JobID          string
+	RunID          string // UUID
+	IdempotencyKey string
+	Args           map[string]any // the run's arguments, used when it is released
+	Priority       *Priority      // overrides the job's when it is released
+	ScheduledFor   time.Time
+}
+
+// InsertPendingRun stores a queued run that the scheduler releases at
+// ScheduledFor. No stream message exists for it until then.
+func (s *Store) InsertPendingRun(ctx context.Context, p InsertPendingRunParams) (*JobRun, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	argsJSON, err := json.Marshal(p.Args)
+	if err != nil {
+		return nil, err
+	}
+	var priority any
+	if p.Priority != nil {
+		priority = string(*p.Priority)
+	}
+	q := `
+INSERT INTO job_runs (job_id, run_id, status, idempotency_key, scheduled_for, args, priority)
+VALUES ($1, $2, 'queued', $3, $4, $5::jsonb, $6)
+RETURNING ` + runColumns
+	var r JobRun
+	if err := scanRun(s.DB.QueryRowContext(ctx, q, p.JobID, p.RunID, p.IdempotencyKey, p.ScheduledFor, string(argsJSON), priority), &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// PendingRun is a delayed run that has not been released yet.
+type PendingRun struct {
+	JobRun
+	Args     map[string]any
+	Priority *Priority // nil = the job's
+}
+
+// DuePendingRunsTx locks up to limit pending runs due at now, earliest first.
+// Rows another scanner holds are skipped.
+func (s *Store) DuePendingRunsTx(ctx context.Context, tx *sql.Tx, now time.Time, limit int) ([]PendingRun, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	rows, err := tx.QueryContext(ctx, `
+SELECT `+runColumns+`, COALESCE(args, '{}'::jsonb), priority
+FROM job_runs
+WHERE scheduled_for <= $1 AND released_at IS NULL AND status = 'queued'
+ORDER BY scheduled_for
+LIMIT $2
+FOR UPDATE SKIP LOCKED`, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []PendingRun
+	for rows.Next() {
+		var (
+			p       PendingRun
+			argsRaw []byte
+		)
+		r := &p.JobRun
+		if err := rows.Scan(&r.ID, &r.JobID, &r.RunID, &r.StartedAt, &r.FinishedAt, &r.Status, &r.Attempts, &r.ErrorText, &r.ErrorClass, &r.WorkerID, &r.IdempotencyKey, &r.ScheduledFor, &r.ThrottleCount, &r.ThrottledAt, &r.ThrottledBy, &argsRaw, &p.Priority); err != nil {
+			return nil, err
+		}
+		_ = json.Unmarshal(argsRaw, &p.Args)
+		out = append(out, p)
+	}
+	return out, rows.Err()
+}
+
+// MarkReleasedTx records that a pending run's message was enqueued.
+func (s *Store) MarkReleasedTx(ctx context.Context, tx *sql.Tx, runID string, at time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	_, err := tx.ExecContext(ctx, `UPDATE job_runs SET released_at = $1 WHERE run_id = $2`, at, runID)
+	return err
+}
+
+// ListPendingRuns returns delayed runs not released yet, soonest first. An
+// empty jobID lists them for all jobs.
+func (s *Store) ListPendingRuns(ctx context.Context, jobID string, limit int) ([]JobRun, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	if limit <= 0 || limit > 200 {
+		limit = 50
+	}
+	rows, err := s.DB.QueryContext(ctx, `
+SELECT `+runColumns+`
+FROM job_runs
+WHERE scheduled_for IS NOT NULL AND released_at IS NULL AND status = 'queued'
+  AND ($1 = '' OR job_id::text = $1)
+ORDER BY scheduled_for
+LIMIT $2;`, jobID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []JobRun
+	for rows.Next() {
+		var r JobRun
+		if err := scanRun(rows, &r); err != nil {
 			return nil, err
 		}
 		out = append(out, r)
 	}
 	return out, rows.Err()
 }
+
+/* ===================== Host rate limits ===================== */
+
+// SetHostRateLimit creates or replaces the limit for host.
+func (s *Store) SetHostRateLimit(ctx context.Context, host string, l RateLimit) (*HostRateLimit, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	var (
+		h   HostRateLimit
+		raw []byte
+	)
+	err := s.DB.QueryRowContext(ctx, `
+INSERT INTO host_rate_limits (host, rate_limit)
+VALUES ($1, $2::jsonb)
+ON CONFLICT (host) DO UPDATE SET rate_limit = EXCLUDED.rate_limit, updated_at = now()
+RETURNING host, rate_limit, updated_at;`, NormalizeHost(host), rateLimitArg(&l)).Scan(&h.Host, &raw, &h.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(raw, &h.RateLimit); err != nil {
+		return nil, err
+	}
+	return &h, nil
+}
+
+// ListHostRateLimits returns every host limit, by host.
+func (s *Store) ListHostRateLimits(ctx context.Context) ([]HostRateLimit, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	rows, err := s.DB.QueryContext(ctx, `SELECT host, rate_limit, updated_at FROM host_rate_limits ORDER BY host`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []HostRateLimit
+	for rows.Next() {
+		var (
+			h   HostRateLimit
+			raw []byte
+		)
+		if err := rows.Scan(&h.Host, &raw, &h.UpdatedAt); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(raw, &h.RateLimit); err != nil {
+			return nil, err
+		}
+		out = append(out, h)
+	}
+	return out, rows.Err()
+}
+
+func (s *Store) DeleteHostRateLimit(ctx context.Context, host string) error {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	res, err := s.DB.ExecContext(ctx, `DELETE FROM host_rate_limits WHERE host = $1`, NormalizeHost(host))
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,591 @@
+package jobs
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// TriggerRule decides when a workflow node runs, based on its upstream nodes.
+type TriggerRule string
+
+const (
+	TriggerAllSuccess TriggerRule = "all_success" // every upstream succeeded
+	TriggerAllDone    TriggerRule = "all_done"    // every upstream finished, whatever the outcome
+	TriggerOneFailed  TriggerRule = "one_failed"  // at least one upstream failed
+)
+
+func (r TriggerRule) valid() bool {
+	switch r {
+	case TriggerAllSuccess, TriggerAllDone, TriggerOneFailed:
+		return true
+	}
+	return false
+}
+
+// WorkflowNode is one job in a workflow.
+type WorkflowNode struct {
+	JobID       string      `json:"job_id"`
+	TriggerRule TriggerRule `json:"trigger_rule"`
+}
+
+// WorkflowEdge makes To depend on From (both job IDs).
+type WorkflowEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type Workflow struct {
+	ID        string         `json:"id"`
+	Name      string         `json:"name"`
+	Enabled   bool           `json:"enabled"`
+	Nodes     []WorkflowNode `json:"nodes"`
+	Edges     []WorkflowEdge `json:"edges"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+// Validate checks that the graph is a DAG over known nodes with valid rules.
+func (w Workflow) Validate() error {
+	if len(w.Nodes) == 0 {
+		return errors.New("workflow needs at least one node")
+	}
+	nodes := map[string]bool{}
+	for _, n := range w.Nodes {
+		if n.JobID == "" {
+			return errors.New("node job_id required")
+		}
+		if nodes[n.JobID] {
+			return fmt.Errorf("job %s appears twice", n.JobID)
+		}
+		if !n.TriggerRule.valid() {
+			return fmt.Errorf("job %s: unknown trigger_rule %q", n.JobID, n.TriggerRule)
+		}
+		nodes[n.JobID] = true
+	}
+	seen := map[WorkflowEdge]bool{}
+	for _, e := range w.Edges {
+		if !nodes[e.From] || !nodes[e.To] {
+			return fmt.Errorf("edge %s -> %s: both ends must be nodes", e.From, e.To)
+		}
+		if e.From == e.To {
+			return fmt.Errorf("edge %s -> %s: self dependency", e.From, e.To)
+		}
+		if seen[e] {
+			return fmt.Errorf("edge %s -> %s appears twice", e.From, e.To)
+		}
+		seen[e] = true
+	}
+	up := w.upstream()
+	for _, n := range w.Nodes {
+		if n.TriggerRule == TriggerOneFailed && len(up[n.JobID]) == 0 {
+			return fmt.Errorf("job %s: one_failed needs an upstream job", n.JobID)
+		}
+	}
+
+	// Kahn's algorithm: anything left unvisited sits on a cycle.
+	indeg := map[string]int{}
+	for _, e := range w.Edges {
+		indeg[e.To]++
+	}
+	var queue []string
+	for _, n := range w.Nodes {
+		if indeg[n.JobID] == 0 {
+			queue = append(queue, n.JobID)
+		}
+	}
+	down := map[string][]string{}
+	for _, e := range w.Edges {
+		down[e.From] = append(down[e.From], e.To)
+	}
+	visited := 0
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		visited++
+		for _, d := range down[n] {
+			if indeg[d]--; indeg[d] == 0 {
+				queue = append(queue, d)
+			}
+		}
+	}
+	if visited != len(w.Nodes) {
+		return errors.New("workflow graph has a cycle")
+	}
+	return nil
+}
+
+func (w Workflow) upstream() map[string][]string {
+	up := map[string][]string{}
+	for _, e := range w.Edges {
+		up[e.To] = append(up[e.To], e.From)
+	}
+	return up
+}
+
+// Node states of a workflow run that have no job_runs status.
+const (
+	NodePending JobRunStatus = "pending" // not enqueued yet
+	NodeSkipped JobRunStatus = "skipped" // its trigger rule can no longer be met
+)
+
+func nodeDone(s JobRunStatus) bool { return s == NodeSkipped || s.Terminal() }
+func nodeFailed(s JobRunStatus) bool {
+	return s == StatusFailed || s == StatusDead
+}
+
+// decide reports whether a pending node with these upstream states should
+// start now, or be skipped because the rule can no longer be met.
+func (r TriggerRule) decide(ups []JobRunStatus) (start, skip bool) {
+	var done, ok, failed int
+	for _, s := range ups {
+		if nodeDone(s) {
+			done++
+		}
+		if s == StatusSuccess {
+			ok++
+		}
+		if nodeFailed(s) {
+			failed++
+		}
+	}
+	all := done == len(ups)
+	switch r {
+	case TriggerAllDone:
+		return all, false
+	case TriggerOneFailed:
+		return failed > 0, all && failed == 0
+	default:
+		return ok == len(ups), done > ok
+	}
+}
+
+// Step returns the pending nodes that should start and those that should be
+// skipped, given the current node states (missing nodes count as pending).
+// Skips cascade to downstream nodes within the same call.
+func (w Workflow) Step(states map[string]JobRunStatus) (start, skip []string) {
+	cur := make(map[string]JobRunStatus, len(states))
+	for k, v := range states {
+		cur[k] = v
+	}
+	up := w.upstream()
+	for changed := true; changed; {
+		changed = false
+		for _, n := range w.Nodes {
+			if s, ok := cur[n.JobID]; ok && s != NodePending {
+				continue
+			}
+			ups := make([]JobRunStatus, 0, len(up[n.JobID]))
+			for _, u := range up[n.JobID] {
+				s, ok := cur[u]
+				if !ok {
+					s = NodePending
+				}
+				ups = append(ups, s)
+			}
+			switch st, sk := n.TriggerRule.decide(ups); {
+			case st:
+				cur[n.JobID] = StatusQueued
+				start = append(start, n.JobID)
+			case sk:
+				cur[n.JobID] = NodeSkipped
+				skip = append(skip, n.JobID)
+				changed = true
+			}
+		}
+	}
+	return start, skip
+}
+
+type WorkflowRunStatus string
+
+const (
+	WorkflowRunning  WorkflowRunStatus = "running"
+	WorkflowSuccess  WorkflowRunStatus = "success"
+	WorkflowFailed   WorkflowRunStatus = "failed"
+	WorkflowCanceled WorkflowRunStatus = "canceled"
+)
+
+// Outcome returns the final status of a workflow run once every node is
+// done: failed if any node failed, else canceled if any was canceled.
+func (w Workflow) Outcome(states map[string]JobRunStatus) (WorkflowRunStatus, bool) {
+	out := WorkflowSuccess
+	for _, n := range w.Nodes {
+		s, ok := states[n.JobID]
+		if !ok || !nodeDone(s) {
+			return WorkflowRunning, false
+		}
+		switch {
+		case nodeFailed(s):
+			out = WorkflowFailed
+		case s == StatusCanceled && out == WorkflowSuccess:
+			out = WorkflowCanceled
+		}
+	}
+	return out, true
+}
+
+type WorkflowRun struct {
+	ID         string            `json:"id"`
+	WorkflowID string            `json:"workflow_id"`
+	Status     WorkflowRunStatus `json:"status"`
+	StartedAt  time.Time         `json:"started_at"`
+	FinishedAt *time.Time        `json:"finished_at,omitempty"`
+	Nodes      []WorkflowRunNode `json:"nodes"`
+}
+
+type WorkflowRunNode struct {
+	JobID  string       `json:"job_id"`
+	RunID  *string      `json:"run_id,omitempty"` // set once enqueued
+	Status JobRunStatus `json:"status"`           // job run status, or pending / skipped
+}
+
+// States maps job ID to node status.
+func (r WorkflowRun) States() map[string]JobRunStatus {
+	out := make(map[string]JobRunStatus, len(r.Nodes))
+	for _, n := range r.Nodes {
+		out[n.JobID] = n.Status
+	}
+	return out
+}
+
+/* ===================== Workflows ===================== */
+
+const workflowColumns = `id, name, enabled, created_at, updated_at`
+
+type CreateWorkflowParams struct {
+	Name    string
+	Enabled bool
+	Nodes   []WorkflowNode
+	Edges   []WorkflowEdge
+}
+
+func (s *Store) CreateWorkflow(ctx context.Context, p CreateWorkflowParams) (*Workflow, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+
+	tx, err := s.DB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var w Workflow
+	if err := tx.QueryRowContext(ctx, `
+INSERT INTO workflows (name, enabled) VALUES ($1, $2)
+RETURNING `+workflowColumns, p.Name, p.Enabled).
+		Scan(&w.ID, &w.Name, &w.Enabled, &w.CreatedAt, &w.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if err := insertGraph(ctx, tx, w.ID, p.Nodes, p.Edges); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	w.Nodes, w.Edges = p.Nodes, p.Edges
+	return &w, nil
+}
+
+func insertGraph(ctx context.Context, tx *sql.Tx, id string, nodes []WorkflowNode, edges []WorkflowEdge) error {
+	for _, n := range nodes {
+		if _, err := tx.ExecContext(ctx, `
+INSERT INTO workflow_nodes (workflow_id, job_id, trigger_rule) VALUES ($1, $2, $3)`,
+			id, n.JobID, string(n.TriggerRule)); err != nil {
+			return fmt.Errorf("node %s: %w", n.JobID, err)
+		}
+	}
+	for _, e := range edges {
+		if _, err := tx.ExecContext(ctx, `
+INSERT INTO workflow_edges (workflow_id, from_job_id, to_job_id) VALUES ($1, $2, $3)`,
+			id, e.From, e.To); err != nil {
+			return fmt.Errorf("edge %s -> %s: %w", e.From, e.To, err)
+		}
+	}
+	return nil
+}
+
+func (s *Store) GetWorkflow(ctx context.Context, id string) (*Workflow, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	return getWorkflow(ctx, s.DB, id)
+}
+
+// GetWorkflowTx loads the workflow inside the caller's transaction.
+func (s *Store) GetWorkflowTx(ctx context.Context, tx *sql.Tx, id string) (*Workflow, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	return getWorkflow(ctx, tx, id)
+}
+
+func getWorkflow(ctx context.Context, db queryer, id string) (*Workflow, error) {
+	var w Workflow
+	if err := db.QueryRowContext(ctx, `SELECT `+workflowColumns+` FROM workflows WHERE id = $1`, id).
+		Scan(&w.ID, &w.Name, &w.Enabled, &w.CreatedAt, &w.UpdatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	if err := loadGraph(ctx, db, &w); err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
+
+func loadGraph(ctx context.Context, db queryer, w *Workflow) error {
+	rows, err := db.QueryContext(ctx, `
+SELECT job_id, trigger_rule FROM workflow_nodes WHERE workflow_id = $1 ORDER BY job_id`, w.ID)
+	if err != nil {
+		return err
+	}
+	w.Nodes = nil
+	for rows.Next() {
+		var n WorkflowNode
+		if err := rows.Scan(&n.JobID, &n.TriggerRule); err != nil {
+			rows.Close()
+			return err
+		}
+		w.Nodes = append(w.Nodes, n)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	rows, err = db.QueryContext(ctx, `
+SELECT from_job_id, to_job_id FROM workflow_edges WHERE workflow_id = $1 ORDER BY from_job_id, to_job_id`, w.ID)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	w.Edges = nil
+	for rows.Next() {
+		var e WorkflowEdge
+		if err := rows.Scan(&e.From, &e.To); err != nil {
+			return err
+		}
+		w.Edges = append(w.Edges, e)
+	}
+	return rows.Err()
+}
+
+type ListWorkflowsParams struct {
+	Limit  int
+	Offset int
+}
+
+func (s *Store) ListWorkflows(ctx context.Context, p ListWorkflowsParams) ([]Workflow, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+
+	if p.Limit <= 0 || p.Limit > 200 {
+		p.Limit = 50
+	}
+	rows, err := s.DB.QueryContext(ctx, `SELECT `+workflowColumns+`
+FROM workflows
+ORDER BY created_at DESC
+LIMIT $1 OFFSET $2;`, p.Limit, p.Offset)
+	if err != nil {
+		return nil, err
+	}
+	var out []Workflow
+	for rows.Next() {
+		var w Workflow
+		if err := rows.Scan(&w.ID, &w.Name, &w.Enabled, &w.CreatedAt, &w.UpdatedAt); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		out = append(out, w)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	for i := range out {
+		if err := loadGraph(ctx, s.DB, &out[i]); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+type UpdateWorkflowParams struct {
+	ID      string
+	Name    *string
+	Enabled *bool
+	Nodes   []WorkflowNode // non-empty replaces the whole graph, together with Edges
+	Edges   []WorkflowEdge
+}
+
+// UpdateWorkflow changes the workflow. Runs already in flight keep going and
+// pick up the new graph for nodes that have not been enqueued yet.
+func (s *Store) UpdateWorkflow(ctx context.Context, p UpdateWorkflowParams) (*Workflow, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+
+	set := ""
+	args := []any{}
+	i := 1
+	if p.Name != nil {
+		set += fmt.Sprintf("name = $%d,", i)
+		args = append(args, *p.Name)
+		i++
+	}
+	if p.Enabled != nil {
+		set += fmt.Sprintf("enabled = $%d,", i)
+		args = append(args, *p.Enabled)
+		i++
+	}
+	if set == "" && len(p.Nodes) == 0 {
+		return nil, errors.New("no fields to update")
+	}
+	set += "updated_at = now()"
+	args = append(args, p.ID)
+
+	tx, err := s.DB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	res, err := tx.ExecContext(ctx, fmt.Sprintf(`UPDATE workflows SET %s WHERE id = $%d`, set, i), args...)
+	if err != nil {
+		return nil, err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil, ErrNotFound
+	}
+	if len(p.Nodes) > 0 {
+		// edges cascade with their nodes
+		if _, err := tx.ExecContext(ctx, `DELETE FROM workflow_nodes WHERE workflow_id = $1`, p.ID); err != nil {
+			return nil, err
+		}
+		if err := insertGraph(ctx, tx, p.ID, p.Nodes, p.Edges); err != nil {
+			return nil, err
+		}
+	}
+	w, err := getWorkflow(ctx, tx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// DeleteWorkflow removes the workflow with its runs and schedules.
+func (s *Store) DeleteWorkflow(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	res, err := s.DB.ExecContext(ctx, `DELETE FROM workflows WHERE id=$1`, id)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+/* ===================== Workflow Runs ===================== */
+
+// InsertWorkflowRunTx creates a run of the workflow with every node pending.
+func (s *Store) InsertWorkflowRunTx(ctx context.Context, tx *sql.Tx, id, workflowID string) (*WorkflowRun, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+
+	r := WorkflowRun{ID: id, WorkflowID: workflowID, Status: WorkflowRunning}
+	if err := tx.QueryRowContext(ctx, `
+INSERT INTO workflow_runs (id, workflow_id) VALUES ($1, $2)
+RETURNING started_at`, id, workflowID).Scan(&r.StartedAt); err != nil {
+		return nil, err
+	}
+	if _, err := tx.ExecContext(ctx, `
+INSERT INTO workflow_run_nodes (workflow_run_id, job_id)
+SELECT $1, job_id FROM workflow_nodes WHERE workflow_id = $2`, id, workflowID); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// LockWorkflowRunTx loads a workflow run and its node states, holding a row
+// lock so that concurrent node completions advance it one at a time.
+func (s *Store) LockWorkflowRunTx(ctx context.Context, tx *sql.Tx, id string) (*WorkflowRun, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	return getWorkflowRun(ctx, tx, id, " FOR UPDATE")
+}
+
+func (s *Store) GetWorkflowRun(ctx context.Context, id string) (*WorkflowRun, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	return getWorkflowRun(ctx, s.DB, id, "")
+}
+
+func getWorkflowRun(ctx context.Context, db queryer, id, lock string) (*WorkflowRun, error) {
+	var r WorkflowRun
+	if err := db.QueryRowContext(ctx, `
+SELECT id, workflow_id, status, started_at, finished_at
+FROM workflow_runs
+WHERE id = $1`+lock, id).Scan(&r.ID, &r.WorkflowID, &r.Status, &r.StartedAt, &r.FinishedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	rows, err := db.QueryContext(ctx, `
+SELECT n.job_id, n.run_id, n.skipped, r.status
+FROM workflow_run_nodes n
+LEFT JOIN job_runs r ON r.run_id = n.run_id
+WHERE n.workflow_run_id = $1
+ORDER BY n.job_id`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var n WorkflowRunNode
+		var skipped bool
+		var st *string
+		if err := rows.Scan(&n.JobID, &n.RunID, &skipped, &st); err != nil {
+			return nil, err
+		}
+		switch {
+		case skipped:
+			n.Status = NodeSkipped
+		case st != nil:
+			n.Status = JobRunStatus(*st)
+		case n.RunID != nil:
+			n.Status = StatusQueued // enqueued; the run row commits with it
+		default:
+			n.Status = NodePending
+		}
+		r.Nodes = append(r.Nodes, n)
+	}
+	return &r, rows.Err()
+}
+
+// SetWorkflowNodeTx records that a node was enqueued as runID, or skipped.
+// Nodes added to the workflow after the run started are inserted.
+func (s *Store) SetWorkflowNodeTx(ctx context.Context, tx *sql.Tx, workflowRunID, jobID string, runID *string, skipped bool) error {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	_, err := tx.ExecContext(ctx, `
+INSERT INTO workflow_run_nodes (workflow_run_id, job_id, run_id, skipped)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (workflow_run_id, job_id) DO UPDATE SET run_id = EXCLUDED.run_id, skipped = EXCLUDED.skipped`,
+		workflowRunID, jobID, runID, skipped)
+	return err
+}
+
+func (s *Store) FinishWorkflowRunTx(ctx context.Context, tx *sql.Tx, id string, status WorkflowRunStatus) error {
+	ctx, cancel := context.WithTimeout(ctx, s.DefaultTO)
+	defer cancel()
+	_, err := tx.ExecContext(ctx, `
+UPDATE workflow_runs SET status = $1, finished_at = now() WHERE id = $2`, string(status), id)
+	return err
+}
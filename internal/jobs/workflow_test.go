@@ -0,0 +1,98 @@
+package jobs
+
+import (
+	"reflect"
+	"testing"
+)
+
+// diamond: a -> b, a -> c, {b, c} -> d
+func diamond(dRule TriggerRule) Workflow {
+	return Workflow{
+		Nodes: []WorkflowNode{
+			{JobID: "a", TriggerRule: TriggerAllSuccess},
+			{JobID: "b", TriggerRule: TriggerAllSuccess},
+			{JobID: "c", TriggerRule: TriggerAllSuccess},
+			{JobID: "d", TriggerRule: dRule},
+		},
+		Edges: []WorkflowEdge{{"a", "b"}, {"a", "c"}, {"b", "d"}, {"c", "d"}},
+	}
+}
+
+func TestWorkflow_Validate(t *testing.T) {
+	if err := diamond(TriggerAllDone).Validate(); err != nil {
+		t.Fatalf("diamond: %v", err)
+	}
+	cyclic := diamond(TriggerAllSuccess)
+	cyclic.Edges = append(cyclic.Edges, WorkflowEdge{"d", "a"})
+	bad := map[string]Workflow{
+		"empty":           {},
+		"cycle":           cyclic,
+		"self":            {Nodes: []WorkflowNode{{"a", TriggerAllSuccess}}, Edges: []WorkflowEdge{{"a", "a"}}},
+		"dangling":        {Nodes: []WorkflowNode{{"a", TriggerAllSuccess}}, Edges: []WorkflowEdge{{"a", "x"}}},
+		"rule":            {Nodes: []WorkflowNode{{"a", "sometimes"}}},
+		"dup node":        {Nodes: []WorkflowNode{{"a", TriggerAllSuccess}, {"a", TriggerAllDone}}},
+		"root one_failed": {Nodes: []WorkflowNode{{"a", TriggerOneFailed}}},
+	}
+	for name, w := range bad {
+		if err := w.Validate(); err == nil {
+			t.Errorf("%s: want error", name)
+		}
+	}
+}
+
+func TestWorkflow_Step(t *testing.T) {
+	cases := []struct {
+		name        string
+		rule        TriggerRule
+		states      map[string]JobRunStatus
+		start, skip []string
+	}{
+		{"roots first", TriggerAllSuccess, nil, []string{"a"}, nil},
+		{"fan out", TriggerAllSuccess,
+			map[string]JobRunStatus{"a": StatusSuccess},
+			[]string{"b", "c"}, nil},
+		{"waits for all upstream", TriggerAllSuccess,
+			map[string]JobRunStatus{"a": StatusSuccess, "b": StatusSuccess, "c": StatusRunning},
+			nil, nil},
+		{"all_success joins", TriggerAllSuccess,
+			map[string]JobRunStatus{"a": StatusSuccess, "b": StatusSuccess, "c": StatusSuccess},
+			[]string{"d"}, nil},
+		{"failure skips downstream transitively", TriggerAllSuccess,
+			map[string]JobRunStatus{"a": StatusDead},
+			nil, []string{"b", "c", "d"}},
+		{"all_done runs after skips", TriggerAllDone,
+			map[string]JobRunStatus{"a": StatusDead},
+			[]string{"d"}, []string{"b", "c"}},
+		{"one_failed fires early", TriggerOneFailed,
+			map[string]JobRunStatus{"a": StatusSuccess, "b": StatusFailed, "c": StatusRunning},
+			[]string{"d"}, nil},
+		{"one_failed skipped when nothing failed", TriggerOneFailed,
+			map[string]JobRunStatus{"a": StatusSuccess, "b": StatusSuccess, "c": StatusSuccess},
+			nil, []string{"d"}},
+	}
+	for _, c := range cases {
+		start, skip := diamond(c.rule).Step(c.states)
+		if !reflect.DeepEqual(start, c.start) || !reflect.DeepEqual(skip, c.skip) {
+			t.Errorf("%s: Step = start %v skip %v, want start %v skip %v", c.name, start, skip, c.start, c.skip)
+		}
+	}
+}
+
+func TestWorkflow_Outcome(t *testing.T) {
+	w := diamond(TriggerAllDone)
+	if _, done := w.Outcome(map[string]JobRunStatus{"a": StatusSuccess, "b": StatusRunning}); done {
+		t.Fatalf("unfinished run reported done")
+	}
+	st, done := w.Outcome(map[string]JobRunStatus{"a": StatusSuccess, "b": StatusSuccess, "c": StatusSuccess, "d": StatusSuccess})
+	if !done || st != WorkflowSuccess {
+		t.Fatalf("got %s %v, want success", st, done)
+	}
+	st, _ = w.Outcome(map[string]JobRunStatus{"a": StatusDead, "b": NodeSkipped, "c": NodeSkipped, "d": StatusSuccess})
+	if st != WorkflowFailed {
+		t.Fatalf("got %s, want failed", st)
+	}
+	st, _ = w.Outcome(map[string]JobRunStatus{"a": StatusCanceled, "b": NodeSkipped, "c": NodeSkipped, "d": StatusSuccess})
+	if st != WorkflowCanceled {
+		t.Fatalf("got %s, want canceled", st)
+	}
+}
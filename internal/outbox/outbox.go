@@ -0,0 +1,224 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Enqueue records a stream message in the outbox inside the caller's
+// transaction. The relay publishes it only after the transaction commits.
+func Enqueue(ctx context.Context, tx *sql.Tx, stream string, payload map[string]any) (int64, error) {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return 0, err
+	}
+	var id int64
+	if err := tx.QueryRowContext(ctx, `
+INSERT INTO outbox (stream, payload) VALUES ($1, $2::jsonb) RETURNING id`, stream, string(b)).Scan(&id); err != nil {
+		return 0, fmt.Errorf("outbox insert: %w", err)
+	}
+	return id, nil
+}
+
+// EnqueueBatch records many messages for one stream in a single insert.
+func EnqueueBatch(ctx context.Context, tx *sql.Tx, stream string, payloads []map[string]any) error {
+	if len(payloads) == 0 {
+		return nil
+	}
+	rows := make([]string, len(payloads))
+	for i, p := range payloads {
+		b, err := json.Marshal(p)
+		if err != nil {
+			return err
+		}
+		rows[i] = string(b)
+	}
+	if _, err := tx.ExecContext(ctx, `
+INSERT INTO outbox (stream, payload)
+SELECT $1, p::jsonb FROM unnest($2::text[]) WITH ORDINALITY AS t(p, n) ORDER BY n`, stream, rows); err != nil {
+		return fmt.Errorf("outbox insert: %w", err)
+	}
+	return nil
+}
+
+// Relay publishes committed outbox rows to their Redis streams and marks
+// them sent. Delivery is at-least-once: a row whose XADD succeeded but whose
+// sent_at update was lost is published again on the next pass, so consumers
+// must tolerate duplicate messages for the same run.
+type Relay struct {
+	DB        *sql.DB
+	RDB       *redis.Client
+	Logger    *log.Logger
+	Interval  time.Duration // poll interval when idle
+	BatchSize int
+	Retention time.Duration // how long sent rows are kept
+
+	kick chan struct{}
+}
+
+func NewRelay(db *sql.DB, rdb *redis.Client, logger *log.Logger) *Relay {
+	return &Relay{
+		DB:        db,
+		RDB:       rdb,
+		Logger:    logger,
+		Interval:  500 * time.Millisecond,
+		BatchSize: 100,
+		Retention: 24 * time.Hour,
+		kick:      make(chan struct{}, 1),
+	}
+}
+
+// Notify wakes the relay so freshly committed rows are published without
+// waiting for the next poll.
+func (r *Relay) Notify() {
+	if r == nil {
+		return
+	}
+	select {
+	case r.kick <- struct{}{}:
+	default:
+	}
+}
+
+// Run publishes until ctx is done. Errors (e.g. Redis being unreachable) are
+// logged and retried with backoff; unsent rows simply wait in the table.
+func (r *Relay) Run(ctx context.Context) {
+	backoff := 200 * time.Millisecond
+	maxBackoff := 5 * time.Second
+	lastPrune := time.Time{}
+
+	for {
+		n, err := r.PublishOnce(ctx)
+		wait := r.Interval
+		switch {
+		case err != nil:
+			r.Logger.Printf("outbox relay: %v", err)
+			wait = backoff
+			if backoff < maxBackoff {
+				backoff *= 2
+				if backoff > maxBackoff {
+					backoff = maxBackoff
+				}
+			}
+		case n >= r.BatchSize:
+			// backlog: keep draining
+			backoff = 200 * time.Millisecond
+			wait = 0
+		default:
+			backoff = 200 * time.Millisecond
+		}
+
+		if r.Retention > 0 && time.Since(lastPrune) > time.Minute {
+			if err := r.Prune(ctx, r.Retention); err != nil {
+				r.Logger.Printf("outbox prune: %v", err)
+			}
+			lastPrune = time.Now()
+		}
+
+		if wait == 0 {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+			}
+			continue
+		}
+		t := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return
+		case <-r.kick:
+			t.Stop()
+		case <-t.C:
+		}
+	}
+}
+
+type pending struct {
+	id      int64
+	stream  string
+	payload []byte
+}
+
+// PublishOnce publishes one batch of unsent rows and returns how many were
+// marked sent. Rows are locked with SKIP LOCKED so several relays (API and
+// scheduler instances) can drain the same table without double-publishing.
+func (r *Relay) PublishOnce(ctx context.Context) (int, error) {
+	tx, err := r.DB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	rows, err := tx.QueryContext(ctx, `
+SELECT id, stream, payload
+FROM outbox
+WHERE sent_at IS NULL
+ORDER BY id
+LIMIT $1
+FOR UPDATE SKIP LOCKED`, r.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	var batch []pending
+	for rows.Next() {
+		var p pending
+		if err := rows.Scan(&p.id, &p.stream, &p.payload); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		batch = append(batch, p)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	if len(batch) == 0 {
+		return 0, nil
+	}
+
+	pipe := r.RDB.Pipeline()
+	cmds := make([]*redis.StringCmd, len(batch))
+	for i, p := range batch {
+		cmds[i] = pipe.XAdd(ctx, &redis.XAddArgs{
+			Stream: p.stream,
+			ID:     "*",
+			Values: map[string]any{"data": string(p.payload)},
+		})
+	}
+	_, execErr := pipe.Exec(ctx)
+
+	// Mark whatever made it onto a stream; the rest stays unsent.
+	var sent []int64
+	for i, c := range cmds {
+		if c.Err() == nil {
+			sent = append(sent, batch[i].id)
+		}
+	}
+	if len(sent) > 0 {
+		if _, err := tx.ExecContext(ctx, `UPDATE outbox SET sent_at = now() WHERE id = ANY($1)`, sent); err != nil {
+			return 0, err
+		}
+		if err := tx.Commit(); err != nil {
+			return 0, err
+		}
+	}
+	if execErr != nil && !errors.Is(execErr, redis.Nil) {
+		return len(sent), fmt.Errorf("publish: %d of %d rows failed: %w", len(batch)-len(sent), len(batch), execErr)
+	}
+	return len(sent), nil
+}
+
+// Prune deletes rows that were sent more than olderThan ago.
+func (r *Relay) Prune(ctx context.Context, olderThan time.Duration) error {
+	_, err := r.DB.ExecContext(ctx, `DELETE FROM outbox WHERE sent_at < $1`, time.Now().Add(-olderThan).UTC())
+	return err
+}
@@ -0,0 +1,110 @@
+package outbox
+
+import (
+	"context"
+	"database/sql/driver"
+	"io"
+	"log"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+// passthrough lets slice arguments (id = ANY($1)) reach sqlmock the way the
+// pgx driver accepts them.
+type passthrough struct{}
+
+func (passthrough) ConvertValue(v any) (driver.Value, error) {
+	if dv, err := driver.DefaultParameterConverter.ConvertValue(v); err == nil {
+		return dv, nil
+	}
+	return v, nil
+}
+
+func newRelay(t *testing.T) (*Relay, sqlmock.Sqlmock, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr(), MaxRetries: -1})
+	t.Cleanup(func() { _ = rdb.Close() })
+
+	db, mock, err := sqlmock.New(sqlmock.ValueConverterOption(passthrough{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	return NewRelay(db, rdb, log.New(io.Discard, "", 0)), mock, mr
+}
+
+func outboxRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "stream", "payload"}).
+		AddRow(1, "jobs:scheduled", []byte(`{"run_id":"a"}`)).
+		AddRow(2, "jobs:adhoc", []byte(`{"run_id":"b"}`))
+}
+
+func TestRelay_PublishesAndMarksSent(t *testing.T) {
+	r, mock, mr := newRelay(t)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`FROM outbox\s+WHERE sent_at IS NULL`).WillReturnRows(outboxRows())
+	mock.ExpectExec(`UPDATE outbox SET sent_at = now\(\) WHERE id = ANY\(\$1\)`).
+		WithArgs([]int64{1, 2}).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	n, err := r.PublishOnce(context.Background())
+	if err != nil {
+		t.Fatalf("publish: %v", err)
+	}
+	if n != 2 {
+		t.Fatalf("want 2 sent, got %d", n)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"jobs:scheduled", "jobs:adhoc"} {
+		entries, err := mr.Stream(s)
+		if err != nil || len(entries) != 1 {
+			t.Fatalf("stream %s: want 1 entry, got %d (%v)", s, len(entries), err)
+		}
+	}
+}
+
+func TestRelay_SurvivesRedisOutage(t *testing.T) {
+	r, mock, mr := newRelay(t)
+	addr := mr.Addr()
+	mr.Close()
+
+	// Redis down: nothing is marked, the tx rolls back and rows stay unsent.
+	mock.ExpectBegin()
+	mock.ExpectQuery(`FROM outbox\s+WHERE sent_at IS NULL`).WillReturnRows(outboxRows())
+	mock.ExpectRollback()
+
+	n, err := r.PublishOnce(context.Background())
+	if err == nil {
+		t.Fatalf("expected publish error while redis is down")
+	}
+	if n != 0 {
+		t.Fatalf("want 0 sent, got %d", n)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Redis back: the same rows are picked up again and delivered.
+	if err := mr.StartAddr(addr); err != nil {
+		t.Fatal(err)
+	}
+	mock.ExpectBegin()
+	mock.ExpectQuery(`FROM outbox\s+WHERE sent_at IS NULL`).WillReturnRows(outboxRows())
+	mock.ExpectExec(`UPDATE outbox SET sent_at`).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	if n, err := r.PublishOnce(context.Background()); err != nil || n != 2 {
+		t.Fatalf("after recovery: n=%d err=%v", n, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,43 @@
+package redisx
+
+import (
+	"context"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Run cancellation is signalled over pub/sub on one channel per worker, so
+// only the worker executing the run (job_runs.worker_id) receives it. Queued
+// runs need no signal: the worker skips runs already marked canceled.
+
+func CancelChannel(prefix, workerID string) string { return prefix + workerID }
+
+// PublishCancel asks workerID to stop runID. It returns the number of
+// subscribers that received it (0 if the worker is gone).
+func PublishCancel(ctx context.Context, rdb *redis.Client, prefix, workerID, runID string) (int64, error) {
+	return rdb.Publish(ctx, CancelChannel(prefix, workerID), runID).Result()
+}
+
+// SubscribeCancels calls fn with each run ID sent to workerID until ctx is
+// done. go-redis resubscribes after reconnects; signals published while
+// disconnected are lost.
+func SubscribeCancels(ctx context.Context, rdb *redis.Client, prefix, workerID string, fn func(runID string)) error {
+	sub := rdb.Subscribe(ctx, CancelChannel(prefix, workerID))
+	defer sub.Close()
+	// wait for the subscription to be confirmed
+	if _, err := sub.Receive(ctx); err != nil {
+		return err
+	}
+	ch := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			fn(msg.Payload)
+		}
+	}
+}
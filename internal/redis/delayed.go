@@ -0,0 +1,163 @@
+package redisx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// promoteScript moves up to ARGV[2] members of the sorted set KEYS[1] whose
+// score (available_at_ms) is <= ARGV[1] into the stream KEYS[2]. Running it as
+// a script makes read+XADD+ZREM atomic, so concurrent promoters on several
+// workers never deliver the same member twice.
+var promoteScript = redis.NewScript(`
+local items = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, ARGV[2])
+for _, m in ipairs(items) do
+  redis.call('XADD', KEYS[2], '*', 'data', m)
+  redis.call('ZREM', KEYS[1], m)
+end
+return #items
+`)
+
+// DelayedQueue holds messages that must not be delivered before a point in
+// time. Messages sit in a sorted set scored by their due time (unix ms) and
+// are promoted into Stream once due; until then nothing reads or rewrites
+// them, so a large backlog of delayed retries costs no work.
+type DelayedQueue struct {
+	RDB       *redis.Client
+	Key       string // sorted set
+	Stream    string // destination stream
+	Logger    *log.Logger
+	Interval  time.Duration // max sleep between promotions
+	BatchSize int
+
+	kick chan struct{}
+}
+
+func NewDelayedQueue(rdb *redis.Client, key, stream string, logger *log.Logger) *DelayedQueue {
+	return &DelayedQueue{
+		RDB:       rdb,
+		Key:       key,
+		Stream:    stream,
+		Logger:    logger,
+		Interval:  time.Second,
+		BatchSize: 100,
+		kick:      make(chan struct{}, 1),
+	}
+}
+
+// Schedule stores payload for delivery at (or shortly after) at. The payload
+// is stored as its JSON encoding, which is also the set member, so scheduling
+// an identical payload twice yields a single delivery.
+func (q *DelayedQueue) Schedule(ctx context.Context, payload map[string]any, at time.Time) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	if err := q.RDB.ZAdd(ctx, q.Key, redis.Z{Score: float64(at.UnixMilli()), Member: string(b)}).Err(); err != nil {
+		return err
+	}
+	// Wake the promoter in case this is now the earliest item.
+	if q.kick != nil {
+		select {
+		case q.kick <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+// PromoteDue moves due messages into the stream and returns how many moved.
+func (q *DelayedQueue) PromoteDue(ctx context.Context, now time.Time) (int, error) {
+	n, err := promoteScript.Run(ctx, q.RDB, []string{q.Key, q.Stream},
+		now.UnixMilli(), q.BatchSize).Int()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return 0, err
+	}
+	return n, nil
+}
+
+// NextDue returns the due time of the earliest message, or ok=false when the
+// queue is empty.
+func (q *DelayedQueue) NextDue(ctx context.Context) (time.Time, bool, error) {
+	zs, err := q.RDB.ZRangeWithScores(ctx, q.Key, 0, 0).Result()
+	if err != nil || len(zs) == 0 {
+		return time.Time{}, false, err
+	}
+	return time.UnixMilli(int64(zs[0].Score)), true, nil
+}
+
+// Depth returns the number of waiting messages and how many of them are
+// already due (i.e. waiting on the promoter).
+func (q *DelayedQueue) Depth(ctx context.Context) (total, due int64, err error) {
+	if total, err = q.RDB.ZCard(ctx, q.Key).Result(); err != nil {
+		return 0, 0, err
+	}
+	max := strconv.FormatInt(time.Now().UnixMilli(), 10)
+	if due, err = q.RDB.ZCount(ctx, q.Key, "-inf", max).Result(); err != nil {
+		return 0, 0, err
+	}
+	return total, due, nil
+}
+
+// Run promotes until ctx is done. Between passes it sleeps until the next
+// message is due, capped at Interval so that items scheduled by other
+// processes are picked up promptly.
+func (q *DelayedQueue) Run(ctx context.Context) {
+	backoff := 200 * time.Millisecond
+	max := 5 * time.Second
+
+	for {
+		n, err := q.PromoteDue(ctx, time.Now())
+		wait := q.Interval
+		switch {
+		case err != nil:
+			if ctx.Err() != nil {
+				return
+			}
+			q.Logger.Printf("delayed queue %s: %v", q.Key, err)
+			wait = backoff
+			if backoff < max {
+				backoff *= 2
+				if backoff > max {
+					backoff = max
+				}
+			}
+		case n >= q.BatchSize:
+			// backlog: keep draining
+			backoff = 200 * time.Millisecond
+			wait = 0
+		default:
+			backoff = 200 * time.Millisecond
+			if next, ok, err := q.NextDue(ctx); err == nil && ok {
+				// +1ms: scores are whole milliseconds
+				if d := time.Until(next) + time.Millisecond; d < wait {
+					wait = d
+				}
+			}
+		}
+
+		if wait <= 0 {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+			}
+			continue
+		}
+		t := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return
+		case <-q.kick:
+			t.Stop()
+		case <-t.C:
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package redisx
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func newTestQueue(t *testing.T) (*DelayedQueue, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	return NewDelayedQueue(rdb, "jobs:retry:delayed", "jobs:retry", log.New(io.Discard, "", 0)), mr
+}
+
+func TestDelayedQueue_PromotesOnlyDue(t *testing.T) {
+	q, mr := newTestQueue(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	if err := q.Schedule(ctx, map[string]any{"run_id": "due"}, now.Add(-time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if err := q.Schedule(ctx, map[string]any{"run_id": "later"}, now.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	n, err := q.PromoteDue(ctx, now)
+	if err != nil || n != 1 {
+		t.Fatalf("promote: n=%d err=%v", n, err)
+	}
+	entries, _ := mr.Stream(q.Stream)
+	if len(entries) != 1 || entries[0].Values[1] != `{"run_id":"due"}` {
+		t.Fatalf("stream = %+v", entries)
+	}
+	total, due, err := q.Depth(ctx)
+	if err != nil || total != 1 || due != 0 {
+		t.Fatalf("depth: total=%d due=%d err=%v", total, due, err)
+	}
+}
+
+func TestDelayedQueue_ConcurrentPromotersDeliverOnce(t *testing.T) {
+	q, mr := newTestQueue(t)
+	ctx := context.Background()
+	q.BatchSize = 7 // force several passes per promoter
+
+	const items = 200
+	past := time.Now().Add(-time.Minute)
+	for i := 0; i < items; i++ {
+		if err := q.Schedule(ctx, map[string]any{"run_id": fmt.Sprint(i)}, past); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var wg sync.WaitGroup
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				n, err := q.PromoteDue(ctx, time.Now())
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if n == 0 {
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	entries, _ := mr.Stream(q.Stream)
+	if len(entries) != items {
+		t.Fatalf("stream has %d entries, want %d", len(entries), items)
+	}
+	seen := map[string]bool{}
+	for _, e := range entries {
+		data := e.Values[1]
+		if seen[data] {
+			t.Fatalf("duplicate delivery: %s", data)
+		}
+		seen[data] = true
+	}
+	if total, _, _ := q.Depth(ctx); total != 0 {
+		t.Fatalf("queue not drained: %d left", total)
+	}
+}
+
+func TestDelayedQueue_RunDoesNotSpin(t *testing.T) {
+	q, mr := newTestQueue(t)
+	q.Interval = 50 * time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// A backlog that is not due yet must be left alone.
+	for i := 0; i < 100; i++ {
+		if err := q.Schedule(ctx, map[string]any{"run_id": fmt.Sprint(i)}, time.Now().Add(time.Hour)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	before := mr.CommandCount()
+
+	done := make(chan struct{})
+	go func() { q.Run(ctx); close(done) }()
+	time.Sleep(500 * time.Millisecond)
+	cancel()
+	<-done
+
+	// ~10 passes of promote + peek; a busy loop would issue thousands.
+	if cmds := mr.CommandCount() - before; cmds > 60 {
+		t.Fatalf("promoter issued %d commands in 500ms", cmds)
+	}
+	if mr.Exists(q.Stream) {
+		t.Fatalf("nothing should have been promoted")
+	}
+}
+
+func TestDelayedQueue_RunWakesWhenDue(t *testing.T) {
+	q, mr := newTestQueue(t)
+	q.Interval = time.Hour // rely on next-due timing, not polling
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go q.Run(ctx)
+
+	if err := q.Schedule(ctx, map[string]any{"run_id": "r"}, time.Now().Add(100*time.Millisecond)); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(2 * time.Second)
+	for !mr.Exists(q.Stream) {
+		if time.Now().After(deadline) {
+			t.Fatalf("item was not promoted when due")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
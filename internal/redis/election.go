@@ -3,25 +3,78 @@ package redisx
 import (
 	"context"
 	"os"
+	"strconv"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/redis/go-redis/v9"
 )
 
+// acquireLeaderScript: KEYS[1] = lease key, KEYS[2] = token counter. ARGV =
+// instance, TTL ms. A free lease is taken with the next fencing token, stored
+// in the value as "instance:token" so renewals can tell terms apart. The
+// counter never expires, so tokens only grow unless Redis loses it; see
+// SkipPast.
+var acquireLeaderScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+  return 0
+end
+local token = redis.call('INCR', KEYS[2])
+redis.call('SET', KEYS[1], ARGV[1] .. ':' .. token, 'PX', ARGV[2])
+return token
+`)
+
+// renewLeaderScript extends the lease only if it still holds our value.
+var renewLeaderScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+  return redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// releaseLeaderScript deletes the lease only if it still holds our value.
+var releaseLeaderScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+  return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+// raiseTokenScript: KEYS[1] = token counter, ARGV[1] = floor. The counter
+// is raised to the floor if below it.
+var raiseTokenScript = redis.NewScript(`
+local cur = tonumber(redis.call('GET', KEYS[1]) or '0')
+if cur < tonumber(ARGV[1]) then
+  redis.call('SET', KEYS[1], ARGV[1])
+end
+return 0
+`)
+
+// LeaderElector holds a Redis lease. Each acquisition gets a fencing token
+// larger than any before it; writers pass Token along so a store can reject
+// writes from a leader that has been replaced.
 type LeaderElector struct {
 	rdb      *redis.Client
 	key      string
 	ttl      time.Duration
 	instance string
-	isLeader atomic.Bool
 	cancel   context.CancelFunc
+	done     chan struct{}
+
+	token      atomic.Int64 // 0 when not leader
+	validUntil atomic.Int64 // unix nanos; the lease may have expired after this
+	mu         sync.Mutex
+	value      string // lease value of the current term
 }
 
+// NewLeaderElector holds the lease for ttlSec seconds per renewal; a TTL
+// under a second is raised to one.
 func NewLeaderElector(rdb *redis.Client, key string, ttlSec int, instanceID string) *LeaderElector {
 	if instanceID == "" {
 		instanceID = hostname()
 	}
+	ttlSec = max(ttlSec, 1)
 	le := &LeaderElector{
 		rdb:      rdb,
 		key:      key,
@@ -34,34 +87,15 @@ func NewLeaderElector(rdb *redis.Client, key string, ttlSec int, instanceID stri
 func (l *LeaderElector) Start(ctx context.Context) {
 	ctx, cancel := context.WithCancel(ctx)
 	l.cancel = cancel
+	l.done = make(chan struct{})
 
 	go func() {
-		ticker := time.NewTicker(3 * time.Second)
+		defer close(l.done)
+		ticker := time.NewTicker(min(3*time.Second, l.ttl/3))
 		defer ticker.Stop()
 
 		for {
-			select {
-			case <-ctx.Done():
-				return
-			default:
-			}
-
-			// Try to acquire if not leader
-			if !l.isLeader.Load() {
-				ok, err := l.rdb.SetNX(ctx, l.key, l.instance, l.ttl).Result()
-				if err == nil && ok {
-					l.isLeader.Store(true)
-				}
-			} else {
-				// Renew TTL
-				_ = l.rdb.PExpire(ctx, l.key, l.ttl).Err()
-				// Double-check who owns it (optional)
-				val, _ := l.rdb.Get(ctx, l.key).Result()
-				if val != l.instance {
-					l.isLeader.Store(false)
-				}
-			}
-
+			l.tick(ctx)
 			select {
 			case <-ctx.Done():
 				return
@@ -71,13 +105,86 @@ func (l *LeaderElector) Start(ctx context.Context) {
 	}()
 }
 
+// tick acquires the lease if free, or renews the one we hold.
+func (l *LeaderElector) tick(ctx context.Context) {
+	start := time.Now()
+	if l.token.Load() == 0 {
+		token, err := acquireLeaderScript.Run(ctx, l.rdb, []string{l.key, l.key + ":token"},
+			l.instance, l.ttl.Milliseconds()).Int64()
+		if err != nil || token == 0 {
+			return
+		}
+		l.mu.Lock()
+		l.value = l.instance + ":" + strconv.FormatInt(token, 10)
+		l.mu.Unlock()
+		l.validUntil.Store(start.Add(l.ttl).UnixNano())
+		l.token.Store(token)
+		return
+	}
+
+	l.mu.Lock()
+	value := l.value
+	l.mu.Unlock()
+	n, err := renewLeaderScript.Run(ctx, l.rdb, []string{l.key}, value, l.ttl.Milliseconds()).Int()
+	switch {
+	case err == nil && n == 1:
+		l.validUntil.Store(start.Add(l.ttl).UnixNano())
+	case err == nil:
+		// someone else holds the lease now
+		l.token.Store(0)
+	default:
+		// unknown; IsLeader turns false by itself once the lease could
+		// have expired
+	}
+}
+
+// Stop ends the election loop and gives up the lease if we hold it.
 func (l *LeaderElector) Stop() {
-	if l.cancel != nil {
-		l.cancel()
+	if l.cancel == nil {
+		return
+	}
+	l.cancel()
+	<-l.done
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	_ = l.Release(ctx)
+}
+
+// Release deletes the lease if this instance still holds it, so another
+// instance can take over without waiting for the TTL.
+func (l *LeaderElector) Release(ctx context.Context) error {
+	if l.token.Swap(0) == 0 {
+		return nil
 	}
+	l.mu.Lock()
+	value := l.value
+	l.mu.Unlock()
+	return releaseLeaderScript.Run(ctx, l.rdb, []string{l.key}, value).Err()
 }
 
-func (l *LeaderElector) IsLeader() bool { return l.isLeader.Load() }
+// SkipPast makes the next term's token larger than token: it raises the
+// counter to token if below and gives up the lease. Call it when a store
+// rejects our token; if Redis lost the counter, every later term would
+// otherwise be rejected too.
+func (l *LeaderElector) SkipPast(ctx context.Context, token int64) error {
+	if err := raiseTokenScript.Run(ctx, l.rdb, []string{l.key + ":token"}, token).Err(); err != nil {
+		return err
+	}
+	return l.Release(ctx)
+}
+
+// IsLeader reports whether we hold the lease. It turns false once the lease
+// may have expired, even if Redis could not be reached to find out.
+func (l *LeaderElector) IsLeader() bool { return l.Token() != 0 }
+
+// Token is the fencing token of the current term, or 0 when not leader.
+func (l *LeaderElector) Token() int64 {
+	t := l.token.Load()
+	if t == 0 || time.Now().UnixNano() >= l.validUntil.Load() {
+		return 0
+	}
+	return t
+}
 
 func hostname() string {
 	if h, err := os.Hostname(); err == nil && h != "" {
@@ -0,0 +1,150 @@
+package redisx
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func newTestElectors(t *testing.T, names ...string) ([]*LeaderElector, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	var out []*LeaderElector
+	for _, n := range names {
+		out = append(out, NewLeaderElector(rdb, "scheduler:leader", 10, n))
+	}
+	return out, mr
+}
+
+func TestLeaderElector_TokensGrowPerTerm(t *testing.T) {
+	ls, mr := newTestElectors(t, "a", "b")
+	a, b := ls[0], ls[1]
+	ctx := context.Background()
+
+	a.tick(ctx)
+	b.tick(ctx)
+	if a.Token() != 1 || b.IsLeader() {
+		t.Fatalf("a token %d, b leader %v", a.Token(), b.IsLeader())
+	}
+
+	// a's lease lapses and b takes over with a larger token
+	mr.FastForward(11 * time.Second)
+	b.tick(ctx)
+	if b.Token() != 2 {
+		t.Fatalf("b token = %d, want 2", b.Token())
+	}
+
+	// a's renewal must not extend b's lease
+	a.tick(ctx)
+	if a.IsLeader() {
+		t.Fatalf("a still believes it leads")
+	}
+	if got, _ := mr.Get("scheduler:leader"); got != "b:2" {
+		t.Fatalf("lease value = %q", got)
+	}
+}
+
+func TestLeaderElector_ReleaseOnlyOwnLease(t *testing.T) {
+	ls, mr := newTestElectors(t, "a", "b")
+	a, b := ls[0], ls[1]
+	ctx := context.Background()
+
+	a.tick(ctx)
+	mr.FastForward(11 * time.Second)
+	b.tick(ctx)
+
+	// a was replaced; releasing must leave b's lease alone
+	if err := a.Release(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if !mr.Exists("scheduler:leader") {
+		t.Fatalf("a deleted b's lease")
+	}
+	if err := b.Release(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if mr.Exists("scheduler:leader") || b.IsLeader() {
+		t.Fatalf("b's lease not released")
+	}
+
+	a.tick(ctx)
+	if a.Token() != 3 {
+		t.Fatalf("a token = %d, want 3", a.Token())
+	}
+}
+
+func TestLeaderElector_LeadershipLapsesWithoutRenewal(t *testing.T) {
+	ls, _ := newTestElectors(t, "a")
+	a := ls[0]
+	a.tick(context.Background())
+	if !a.IsLeader() {
+		t.Fatal("not leader")
+	}
+	a.validUntil.Store(time.Now().Add(-time.Second).UnixNano())
+	if a.IsLeader() {
+		t.Fatalf("leader past the lease's expiry")
+	}
+}
+
+func TestLeaderElector_SkipPastRecoversFromCounterReset(t *testing.T) {
+	ls, mr := newTestElectors(t, "a")
+	a := ls[0]
+	ctx := context.Background()
+
+	// tokens up to 5 were claimed, then Redis lost the counter
+	mr.Set("scheduler:leader:token", "5")
+	a.tick(ctx)
+	if a.Token() != 6 {
+		t.Fatalf("token = %d, want 6", a.Token())
+	}
+	_ = a.Release(ctx)
+	mr.Del("scheduler:leader:token")
+	a.tick(ctx)
+	if a.Token() != 1 {
+		t.Fatalf("token after reset = %d, want 1", a.Token())
+	}
+
+	if err := a.SkipPast(ctx, 6); err != nil {
+		t.Fatal(err)
+	}
+	if a.IsLeader() || mr.Exists("scheduler:leader") {
+		t.Fatalf("lease kept after SkipPast")
+	}
+	a.tick(ctx)
+	if a.Token() != 7 {
+		t.Fatalf("token after SkipPast = %d, want 7", a.Token())
+	}
+
+	// a smaller floor leaves the counter alone
+	if err := a.SkipPast(ctx, 3); err != nil {
+		t.Fatal(err)
+	}
+	a.tick(ctx)
+	if a.Token() != 8 {
+		t.Fatalf("token = %d, want 8", a.Token())
+	}
+}
+
+func TestLeaderElector_ZeroTTLStillTicks(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer rdb.Close()
+	l := NewLeaderElector(rdb, "scheduler:leader", 0, "a")
+	if l.ttl != time.Second {
+		t.Fatalf("ttl = %v, want 1s", l.ttl)
+	}
+	l.Start(context.Background())
+	defer l.Stop()
+	deadline := time.Now().Add(time.Second)
+	for !l.IsLeader() && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if !l.IsLeader() {
+		t.Fatal("not leader")
+	}
+}
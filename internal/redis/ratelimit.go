@@ -0,0 +1,86 @@
+package redisx
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// gcraScript implements GCRA over several limits at once. Each of KEYS holds
+// a theoretical arrival time (TAT) in unix µs. ARGV = now µs, then emission
+// interval µs and burst per key. A limit allows a request when its TAT,
+// pushed forward by one interval, is at most burst intervals ahead of now.
+// Tokens are only taken when every limit allows; otherwise nothing changes
+// and the script returns the 1-based index of the limit with the longest
+// wait and that wait in µs. A key expires once its TAT has passed, when the
+// limit is back at full burst.
+var gcraScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local tats = {}
+local worst, worstWait = 0, 0
+for i, key in ipairs(KEYS) do
+  local interval = tonumber(ARGV[2 * i])
+  local burst = tonumber(ARGV[2 * i + 1])
+  local tat = tonumber(redis.call('GET', key) or '0')
+  if tat < now then
+    tat = now
+  end
+  local wait = tat + interval - burst * interval - now
+  if wait > worstWait then
+    worst, worstWait = i, wait
+  end
+  tats[i] = tat + interval
+end
+if worst > 0 then
+  return {worst, worstWait}
+end
+for i, key in ipairs(KEYS) do
+  redis.call('SET', key, string.format('%d', tats[i]), 'PX', math.max(1, math.ceil((tats[i] - now) / 1000)))
+end
+return {0, 0}
+`)
+
+// RateLimiter enforces rate limits per name (e.g. a job ID or an HTTP host)
+// across all workers.
+type RateLimiter struct {
+	RDB    *redis.Client
+	Prefix string
+}
+
+func NewRateLimiter(rdb *redis.Client, prefix string) *RateLimiter {
+	return &RateLimiter{RDB: rdb, Prefix: prefix}
+}
+
+// Limit is Limit requests per Period for Name, in bursts of up to Burst.
+type Limit struct {
+	Name   string
+	Limit  int
+	Period time.Duration
+	Burst  int
+}
+
+// Allow takes a token from every limit, or from none if any has none left.
+// In that case it returns the index of the limit to wait longest for and how
+// long; otherwise it returns -1.
+func (l *RateLimiter) Allow(ctx context.Context, limits ...Limit) (int, time.Duration, error) {
+	if len(limits) == 0 {
+		return -1, 0, nil
+	}
+	keys := make([]string, len(limits))
+	args := make([]any, 0, 1+2*len(limits))
+	args = append(args, time.Now().UnixMicro())
+	for i, lim := range limits {
+		interval := lim.Period.Microseconds() / int64(max(lim.Limit, 1))
+		keys[i] = l.Prefix + lim.Name
+		args = append(args, max(interval, 1), max(lim.Burst, 1))
+	}
+	res, err := gcraScript.Run(ctx, l.RDB, keys, args...).Int64Slice()
+	if err != nil {
+		return -1, 0, err
+	}
+	if res[0] == 0 {
+		return -1, 0, nil
+	}
+	return int(res[0]) - 1, time.Duration(res[1]) * time.Microsecond, nil
+}
@@ -0,0 +1,70 @@
+package redisx
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func newTestRateLimiter(t *testing.T) (*RateLimiter, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	return NewRateLimiter(rdb, "jobs:rate:"), mr
+}
+
+func TestRateLimiter_BurstThenSpaced(t *testing.T) {
+	l, mr := newTestRateLimiter(t)
+	ctx := context.Background()
+
+	// 10/min with a burst of 3: three at once, then one every 6s
+	job := Limit{Name: "job", Limit: 10, Period: time.Minute, Burst: 3}
+	for i := 0; i < 3; i++ {
+		if idx, wait, err := l.Allow(ctx, job); err != nil || idx != -1 {
+			t.Fatalf("call %d: limit %d wait=%v err=%v", i, idx, wait, err)
+		}
+	}
+	idx, wait, err := l.Allow(ctx, job)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if idx != 0 || wait <= 5*time.Second || wait > 6*time.Second {
+		t.Fatalf("fourth call: limit %d waits %v, want 0 and about 6s", idx, wait)
+	}
+	// other names are independent
+	if idx, wait, _ := l.Allow(ctx, Limit{Name: "host:api.example.com", Limit: 10, Period: time.Minute, Burst: 3}); idx != -1 {
+		t.Fatalf("limits are per name, waited %v", wait)
+	}
+	if ttl := mr.TTL("jobs:rate:job"); ttl <= 0 || ttl > 18*time.Second {
+		t.Fatalf("key ttl = %v, want until the burst refills", ttl)
+	}
+}
+
+func TestRateLimiter_DeniedTakesNoTokens(t *testing.T) {
+	l, mr := newTestRateLimiter(t)
+	ctx := context.Background()
+
+	job := Limit{Name: "job:j", Limit: 2, Period: time.Minute, Burst: 2}
+	host := Limit{Name: "host:api.example.com", Limit: 1, Period: time.Minute, Burst: 1}
+	if idx, _, _ := l.Allow(ctx, job, host); idx != -1 {
+		t.Fatal("first call throttled")
+	}
+	before, _ := mr.Get("jobs:rate:job:j")
+
+	// the host is out; the job's own limit must not pay for the attempts
+	for i := 0; i < 3; i++ {
+		if idx, _, _ := l.Allow(ctx, job, host); idx != 1 {
+			t.Fatalf("attempt %d: throttled by %d, want the host", i, idx)
+		}
+	}
+	if after, _ := mr.Get("jobs:rate:job:j"); after != before {
+		t.Fatalf("job TAT moved from %s to %s while the host throttled", before, after)
+	}
+	if idx, _, _ := l.Allow(ctx, job); idx != -1 {
+		t.Fatal("job limit drained by host throttling")
+	}
+}
@@ -0,0 +1,139 @@
+package redisx
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// acquireScript: KEYS[1] = holders zset (member=holder, score=lease expiry
+// ms), KEYS[2] = owners hash. ARGV = now ms, expiry ms, limit, holder, owner.
+// Expired leases are dropped first; a holder that already owns a slot just
+// renews it, so acquiring is idempotent per holder. Every lease is at most
+// TTL long, so the keys themselves expire with the newest lease.
+var acquireScript = redis.NewScript(`
+local expired = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1])
+if #expired > 0 then
+  redis.call('ZREM', KEYS[1], unpack(expired))
+  redis.call('HDEL', KEYS[2], unpack(expired))
+end
+if redis.call('ZSCORE', KEYS[1], ARGV[4]) or redis.call('ZCARD', KEYS[1]) < tonumber(ARGV[3]) then
+  redis.call('ZADD', KEYS[1], ARGV[2], ARGV[4])
+  redis.call('HSET', KEYS[2], ARGV[4], ARGV[5])
+  redis.call('PEXPIREAT', KEYS[1], ARGV[2])
+  redis.call('PEXPIREAT', KEYS[2], ARGV[2])
+  return 1
+end
+return 0
+`)
+
+// refreshScript extends a lease the holder still owns.
+var refreshScript = redis.NewScript(`
+if not redis.call('ZSCORE', KEYS[1], ARGV[2]) then
+  return 0
+end
+redis.call('ZADD', KEYS[1], ARGV[1], ARGV[2])
+redis.call('PEXPIREAT', KEYS[1], ARGV[1])
+redis.call('PEXPIREAT', KEYS[2], ARGV[1])
+return 1
+`)
+
+// Semaphore is a counting semaphore per name (job ID) shared by all workers.
+// Slots are leases: a holder that dies without releasing loses its slot once
+// TTL passes without a Refresh.
+type Semaphore struct {
+	RDB    *redis.Client
+	Prefix string
+	TTL    time.Duration
+}
+
+func NewSemaphore(rdb *redis.Client, prefix string, ttl time.Duration) *Semaphore {
+	return &Semaphore{RDB: rdb, Prefix: prefix, TTL: ttl}
+}
+
+// SlotHolder is one current lease.
+type SlotHolder struct {
+	Holder    string // run ID
+	Owner     string // worker (consumer) name
+	ExpiresAt time.Time
+}
+
+func (s *Semaphore) keys(name string) []string {
+	k := s.Prefix + name
+	return []string{k, k + ":owners"}
+}
+
+// Acquire takes a slot for holder if fewer than limit are held.
+func (s *Semaphore) Acquire(ctx context.Context, name string, limit int, holder, owner string) (bool, error) {
+	now := time.Now()
+	n, err := acquireScript.Run(ctx, s.RDB, s.keys(name),
+		now.UnixMilli(), now.Add(s.TTL).UnixMilli(), limit, holder, owner).Int()
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
+
+// Refresh extends holder's lease; false means the lease was already lost.
+func (s *Semaphore) Refresh(ctx context.Context, name, holder string) (bool, error) {
+	exp := time.Now().Add(s.TTL)
+	n, err := refreshScript.Run(ctx, s.RDB, s.keys(name), exp.UnixMilli(), holder).Int()
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
+
+func (s *Semaphore) Release(ctx context.Context, name, holder string) error {
+	k := s.keys(name)
+	pipe := s.RDB.TxPipeline()
+	pipe.ZRem(ctx, k[0], holder)
+	pipe.HDel(ctx, k[1], holder)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// Holders lists unexpired leases, soonest expiry first.
+func (s *Semaphore) Holders(ctx context.Context, name string) ([]SlotHolder, error) {
+	k := s.keys(name)
+	zs, err := s.RDB.ZRangeByScoreWithScores(ctx, k[0], &redis.ZRangeBy{
+		Min: "(" + strconv.FormatInt(time.Now().UnixMilli(), 10), Max: "+inf",
+	}).Result()
+	if err != nil || len(zs) == 0 {
+		return nil, err
+	}
+	ids := make([]string, len(zs))
+	for i, z := range zs {
+		ids[i], _ = z.Member.(string)
+	}
+	owners, err := s.RDB.HMGet(ctx, k[1], ids...).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+	out := make([]SlotHolder, len(zs))
+	for i, z := range zs {
+		out[i] = SlotHolder{Holder: ids[i], ExpiresAt: time.UnixMilli(int64(z.Score))}
+		if i < len(owners) {
+			out[i].Owner, _ = owners[i].(string)
+		}
+	}
+	return out, nil
+}
+
+// Names lists the names that currently have a holders set (for admin use).
+func (s *Semaphore) Names(ctx context.Context) ([]string, error) {
+	var out []string
+	iter := s.RDB.Scan(ctx, 0, s.Prefix+"*", 100).Iterator()
+	for iter.Next(ctx) {
+		key := iter.Val()
+		if strings.HasSuffix(key, ":owners") {
+			continue
+		}
+		out = append(out, strings.TrimPrefix(key, s.Prefix))
+	}
+	return out, iter.Err()
+}
@@ -0,0 +1,75 @@
+package redisx
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func newTestSemaphore(t *testing.T) (*Semaphore, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	return NewSemaphore(rdb, "jobs:slots:", time.Minute), mr
+}
+
+func TestSemaphore_LimitAndRelease(t *testing.T) {
+	s, _ := newTestSemaphore(t)
+	ctx := context.Background()
+
+	for _, run := range []string{"r1", "r2"} {
+		if ok, err := s.Acquire(ctx, "job", 2, run, "w1"); err != nil || !ok {
+			t.Fatalf("acquire %s: ok=%v err=%v", run, ok, err)
+		}
+	}
+	if ok, _ := s.Acquire(ctx, "job", 2, "r3", "w2"); ok {
+		t.Fatalf("third holder must not get a slot")
+	}
+	// re-acquiring a held slot (redelivery of the same run) succeeds
+	if ok, _ := s.Acquire(ctx, "job", 2, "r1", "w2"); !ok {
+		t.Fatalf("holder should be able to re-acquire its own slot")
+	}
+	// other jobs are independent
+	if ok, _ := s.Acquire(ctx, "other", 1, "r3", "w2"); !ok {
+		t.Fatalf("slots are per job")
+	}
+
+	holders, err := s.Holders(ctx, "job")
+	if err != nil || len(holders) != 2 {
+		t.Fatalf("holders = %+v (%v)", holders, err)
+	}
+	if err := s.Release(ctx, "job", "r2"); err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := s.Acquire(ctx, "job", 2, "r3", "w2"); !ok {
+		t.Fatalf("released slot should be reusable")
+	}
+}
+
+func TestSemaphore_LeaseExpiry(t *testing.T) {
+	s, _ := newTestSemaphore(t)
+	s.TTL = 50 * time.Millisecond
+	ctx := context.Background()
+
+	if ok, _ := s.Acquire(ctx, "job", 1, "dead-run", "crashed-worker"); !ok {
+		t.Fatal("acquire")
+	}
+	if ok, _ := s.Acquire(ctx, "job", 1, "r2", "w"); ok {
+		t.Fatalf("slot should still be held")
+	}
+	time.Sleep(80 * time.Millisecond)
+	if ok, _ := s.Acquire(ctx, "job", 1, "r2", "w"); !ok {
+		t.Fatalf("expired lease should free the slot")
+	}
+	if ok, _ := s.Refresh(ctx, "job", "dead-run"); ok {
+		t.Fatalf("refresh of an expired lease must report loss")
+	}
+	holders, _ := s.Holders(ctx, "job")
+	if len(holders) != 1 || holders[0].Holder != "r2" || holders[0].Owner != "w" {
+		t.Fatalf("holders = %+v", holders)
+	}
+}
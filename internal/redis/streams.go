@@ -12,23 +12,132 @@ import (
 )
 
 type StreamsConfig struct {
-	Scheduled     string
-	Adhoc         string
+	Scheduled     string // normal-priority scheduled runs
+	Adhoc         string // normal-priority ad-hoc runs
+	High          string // high-priority runs, scheduled or ad hoc
+	Low           string // low-priority runs
 	Retry         string
+	RetryDelayed  string // sorted set of retries not yet due
 	DLQ           string
 	ConsumerGroup string
+	SlotPrefix    string   // per-job concurrency semaphores
+	RatePrefix    string   // per-job and per-host rate limits
+	CancelPrefix  string   // pub/sub channel prefix for run cancellation, + worker id
+	Queues        []string // named queues besides the default one
 }
 
 func StreamsFromEnv() StreamsConfig {
 	return StreamsConfig{
 		Scheduled:     getenv("REDIS_STREAM_SCHEDULED", "jobs:scheduled"),
 		Adhoc:         getenv("REDIS_STREAM_ADHOC", "jobs:adhoc"),
+		High:          getenv("REDIS_STREAM_HIGH", "jobs:high"),
+		Low:           getenv("REDIS_STREAM_LOW", "jobs:low"),
 		Retry:         getenv("REDIS_STREAM_RETRY", "jobs:retry"),
+		RetryDelayed:  getenv("REDIS_RETRY_DELAYED_KEY", "jobs:retry:delayed"),
 		DLQ:           getenv("REDIS_STREAM_DLQ", "jobs:dlq"),
 		ConsumerGroup: getenv("REDIS_CONSUMER_GROUP", "cg:workers"),
+		SlotPrefix:    getenv("REDIS_SLOT_PREFIX", "jobs:slots:"),
+		RatePrefix:    getenv("REDIS_RATE_PREFIX", "jobs:rate:"),
+		CancelPrefix:  getenv("REDIS_CANCEL_PREFIX", "jobs:cancel:"),
+		Queues:        SplitList(getenv("REDIS_QUEUES", "")),
 	}
 }
 
+// SplitList splits a comma-separated list, dropping empty entries.
+func SplitList(s string) []string {
+	var out []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// DefaultQueue is the queue of jobs that do not name one. Its runs use the
+// configured streams as they are.
+const DefaultQueue = "default"
+
+// KnownQueue reports whether name is the default queue or one of Queues.
+func (c StreamsConfig) KnownQueue(name string) bool {
+	if name == "" || name == DefaultQueue {
+		return true
+	}
+	for _, q := range c.Queues {
+		if q == name {
+			return true
+		}
+	}
+	return false
+}
+
+// QueueStream is stream's counterpart in the named queue: stream itself
+// for the default queue, otherwise stream + ":" + queue.
+func QueueStream(stream, queue string) string {
+	if stream == "" || queue == "" || queue == DefaultQueue {
+		return stream
+	}
+	return stream + ":" + queue
+}
+
+// ForQueue returns the config with the run and retry streams of the named
+// queue, so that only workers subscribed to it see its runs. The DLQ,
+// consumer group and key prefixes are shared by all queues.
+func (c StreamsConfig) ForQueue(queue string) StreamsConfig {
+	c.Scheduled = QueueStream(c.Scheduled, queue)
+	c.Adhoc = QueueStream(c.Adhoc, queue)
+	c.High = QueueStream(c.High, queue)
+	c.Low = QueueStream(c.Low, queue)
+	c.Retry = QueueStream(c.Retry, queue)
+	c.RetryDelayed = QueueStream(c.RetryDelayed, queue)
+	return c
+}
+
+// Priority tiers, highest first.
+const (
+	TierHigh   = "high"
+	TierNormal = "normal"
+	TierLow    = "low"
+)
+
+// ForPriority returns the stream for a run of the given priority tier that
+// would go to stream at normal priority. Tiers without a stream configured
+// fall back to stream.
+func (c StreamsConfig) ForPriority(stream, priority string) string {
+	switch {
+	case priority == TierHigh && c.High != "":
+		return c.High
+	case priority == TierLow && c.Low != "":
+		return c.Low
+	}
+	return stream
+}
+
+// Route returns the stream for a run of the given queue and priority that
+// would go to stream in the default queue at normal priority.
+func (c StreamsConfig) Route(stream, queue, priority string) string {
+	return QueueStream(c.ForPriority(stream, priority), queue)
+}
+
+// Tier is a priority tier and the streams its runs are read from.
+type Tier struct {
+	Name    string
+	Streams []string
+}
+
+// Tiers lists the configured tiers, highest first.
+func (c StreamsConfig) Tiers() []Tier {
+	var out []Tier
+	if c.High != "" {
+		out = append(out, Tier{TierHigh, []string{c.High}})
+	}
+	out = append(out, Tier{TierNormal, []string{c.Scheduled, c.Adhoc}})
+	if c.Low != "" {
+		out = append(out, Tier{TierLow, []string{c.Low}})
+	}
+	return out
+}
+
 func EnsureGroup(ctx context.Context, rdb *redis.Client, stream, group string) error {
 	err := rdb.XGroupCreateMkStream(ctx, stream, group, "0").Err()
 	if err != nil && !isBusyGroup(err) {
@@ -66,10 +175,11 @@ type ReadOptions struct {
 }
 
 type DecodedMessage struct {
-	Stream  string
-	ID      string
-	Payload map[string]any
-	Raw     redis.XMessage
+	Stream     string
+	ID         string
+	Payload    map[string]any
+	Raw        redis.XMessage
+	Deliveries int64 // PEL delivery count; only set for claimed messages
 }
 
 func XReadGroupJSON(ctx context.Context, rdb *redis.Client, opt ReadOptions) ([]DecodedMessage, error) {
@@ -90,46 +200,80 @@ func XReadGroupJSON(ctx context.Context, rdb *redis.Client, opt ReadOptions) ([]
 	var out []DecodedMessage
 	for _, s := range res {
 		for _, m := range s.Messages {
-			var item map[string]any
-			if raw, ok := m.Values["data"].(string); ok && raw != "" {
-				_ = json.Unmarshal([]byte(raw), &item)
-			}
-			out = append(out, DecodedMessage{
-				Stream:  s.Stream,
-				ID:      m.ID,
-				Payload: item,
-				Raw:     m,
-			})
+			out = append(out, decode(s.Stream, m))
 		}
 	}
 	return out, nil
 }
 
+func decode(stream string, m redis.XMessage) DecodedMessage {
+	var item map[string]any
+	if raw, ok := m.Values["data"].(string); ok && raw != "" {
+		_ = json.Unmarshal([]byte(raw), &item)
+	}
+	return DecodedMessage{
+		Stream:  stream,
+		ID:      m.ID,
+		Payload: item,
+		Raw:     m,
+	}
+}
+
 func Ack(ctx context.Context, rdb *redis.Client, stream, group string, ids ...string) (int64, error) {
 	return rdb.XAck(ctx, stream, group, ids...).Result()
 }
 
-func ClaimPending(ctx context.Context, rdb *redis.Client, stream, group, consumer string, idleFor time.Duration, count int64) ([]redis.XMessage, error) {
-	pending, err := rdb.XPendingExt(ctx, &redis.XPendingExtArgs{
-		Stream: stream, Group: group, Start: "-", End: "+", Count: count,
+// AutoClaimJSON transfers to consumer up to count pending entries that have
+// been idle for at least minIdle, scanning the PEL from start. It returns the
+// claimed messages with their delivery counts (including this claim) and the
+// cursor for the next call, which is "0-0" once the whole PEL was scanned.
+func AutoClaimJSON(ctx context.Context, rdb *redis.Client, stream, group, consumer string, minIdle time.Duration, start string, count int64) ([]DecodedMessage, string, error) {
+	msgs, next, err := rdb.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+		Stream:   stream,
+		Group:    group,
+		Consumer: consumer,
+		MinIdle:  minIdle,
+		Start:    start,
+		Count:    count,
 	}).Result()
 	if err != nil && !errors.Is(err, redis.Nil) {
-		return nil, err
+		return nil, "", err
 	}
-	var ids []string
-	for _, p := range pending {
-		if p.Idle >= idleFor {
-			ids = append(ids, p.ID)
-		}
+	if len(msgs) == 0 {
+		return nil, next, nil
 	}
-	if len(ids) == 0 {
-		return nil, nil
+
+	// XAUTOCLAIM does not report delivery counts; read them back from the PEL.
+	pipe := rdb.Pipeline()
+	cmds := make([]*redis.XPendingExtCmd, len(msgs))
+	for i, m := range msgs {
+		cmds[i] = pipe.XPendingExt(ctx, &redis.XPendingExtArgs{
+			Stream: stream, Group: group, Start: m.ID, End: m.ID, Count: 1,
+		})
+	}
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, "", err
 	}
-	return rdb.XClaim(ctx, &redis.XClaimArgs{
+
+	out := make([]DecodedMessage, 0, len(msgs))
+	for i, m := range msgs {
+		d := decode(stream, m)
+		if p := cmds[i].Val(); len(p) == 1 {
+			d.Deliveries = p[0].RetryCount
+		}
+		out = append(out, d)
+	}
+	return out, next, nil
+}
+
+// Touch resets the idle time of pending entries owned by consumer without
+// bumping their delivery count, so a long-running message is not mistaken
+// for one abandoned by a dead worker.
+func Touch(ctx context.Context, rdb *redis.Client, stream, group, consumer string, ids ...string) error {
+	return rdb.XClaimJustID(ctx, &redis.XClaimArgs{
 		Stream:   stream,
 		Group:    group,
 		Consumer: consumer,
-		MinIdle:  idleFor,
 		Messages: ids,
-	}).Result()
+	}).Err()
 }
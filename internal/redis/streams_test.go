@@ -0,0 +1,24 @@
+package redisx
+
+import "testing"
+
+func TestStreamsConfig_Route(t *testing.T) {
+	c := StreamsConfig{Scheduled: "jobs:scheduled", Adhoc: "jobs:adhoc", High: "jobs:high", Queues: []string{"gpu"}}
+	for _, tc := range []struct {
+		stream, queue, priority, want string
+	}{
+		{"jobs:adhoc", "", "normal", "jobs:adhoc"},
+		{"jobs:adhoc", DefaultQueue, "high", "jobs:high"},
+		{"jobs:scheduled", "gpu", "normal", "jobs:scheduled:gpu"},
+		{"jobs:scheduled", "gpu", "high", "jobs:high:gpu"},
+		// no low stream configured: the run stays on its normal stream
+		{"jobs:adhoc", "gpu", "low", "jobs:adhoc:gpu"},
+	} {
+		if got := c.Route(tc.stream, tc.queue, tc.priority); got != tc.want {
+			t.Errorf("Route(%q, %q, %q) = %q, want %q", tc.stream, tc.queue, tc.priority, got, tc.want)
+		}
+	}
+	if !c.KnownQueue("") || !c.KnownQueue("gpu") || c.KnownQueue("db") {
+		t.Errorf("KnownQueue does not match Queues %v", c.Queues)
+	}
+}
@@ -0,0 +1,147 @@
+package schedule
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/rishansujesh/job-scheduler/internal/jobs"
+)
+
+// maxSuppressed bounds how many excluded occurrences in a row are skipped
+// looking for the next allowed one.
+const maxSuppressed = 100000
+
+// Exclusion says which calendar excluded an occurrence and why.
+type Exclusion struct {
+	CalendarID string
+	Reason     string // e.g. `date 2025-12-25 "Christmas Day"`
+}
+
+// Suppressed summarizes consecutive occurrences stopped by one exclusion.
+type Suppressed struct {
+	Exclusion
+	Count       int
+	First, Last time.Time
+}
+
+// addSuppressed records t, extending the last entry if the same exclusion
+// stopped the previous occurrence too.
+func addSuppressed(list []Suppressed, ex Exclusion, t time.Time) []Suppressed {
+	if n := len(list); n > 0 && list[n-1].Exclusion == ex {
+		list[n-1].Count++
+		list[n-1].Last = t
+		return list
+	}
+	return append(list, Suppressed{Exclusion: ex, Count: 1, First: t, Last: t})
+}
+
+// Calendars are compiled calendars; a nil *Calendars excludes nothing.
+type Calendars struct {
+	cals []calendar
+}
+
+type calendar struct {
+	id      string
+	loc     *time.Location
+	dates   map[string]string // YYYY-MM-DD -> label
+	windows []window
+}
+
+type window struct {
+	sched cron.Schedule
+	dur   time.Duration
+	label string
+	expr  string
+}
+
+// CompileCalendars parses the calendars' windows and timezones.
+func CompileCalendars(cals []jobs.Calendar) (*Calendars, error) {
+	if len(cals) == 0 {
+		return nil, nil
+	}
+	out := &Calendars{}
+	for _, c := range cals {
+		loc, err := location(c.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("calendar %s: %w", c.Name, err)
+		}
+		cc := calendar{id: c.ID, loc: loc, dates: make(map[string]string, len(c.Dates))}
+		for _, d := range c.Dates {
+			cc.dates[d.Day.Format(time.DateOnly)] = d.Label
+		}
+		for _, w := range c.Windows {
+			sched, err := ParseCron(w.CronExpr)
+			if err != nil {
+				return nil, fmt.Errorf("calendar %s: window %q: %w", c.Name, w.CronExpr, err)
+			}
+			if ws, _ := wallSchedule(sched, loc); ws == nil {
+				return nil, fmt.Errorf("calendar %s: window %q: @every has no fixed start", c.Name, w.CronExpr)
+			}
+			if w.DurationSeconds <= 0 {
+				return nil, fmt.Errorf("calendar %s: window %q: duration must be > 0", c.Name, w.CronExpr)
+			}
+			cc.windows = append(cc.windows, window{
+				sched: sched, dur: time.Duration(w.DurationSeconds) * time.Second, label: w.Label, expr: w.CronExpr,
+			})
+		}
+		out.cals = append(out.cals, cc)
+	}
+	return out, nil
+}
+
+// Excludes reports the first calendar that excludes t.
+func (c *Calendars) Excludes(t time.Time) (Exclusion, bool) {
+	if c == nil {
+		return Exclusion{}, false
+	}
+	for _, cal := range c.cals {
+		day := t.In(cal.loc).Format(time.DateOnly)
+		if label, ok := cal.dates[day]; ok {
+			return Exclusion{CalendarID: cal.id, Reason: describe("date "+day, label)}, true
+		}
+		for _, w := range cal.windows {
+			// the first window start after t-dur covers t if it is not after t
+			start := nextCron(w.sched, t.Add(-w.dur).In(cal.loc), cal.loc)
+			if !start.IsZero() && !start.After(t) {
+				return Exclusion{CalendarID: cal.id, Reason: describe("window "+w.expr, w.label)}, true
+			}
+		}
+	}
+	return Exclusion{}, false
+}
+
+func describe(what, label string) string {
+	if label == "" {
+		return what
+	}
+	return fmt.Sprintf("%s %q", what, label)
+}
+
+// skipExcluded returns t if no calendar excludes it, else the first later
+// occurrence that is allowed. Skipped occurrences are added to sup.
+func skipExcluded(sc jobs.Schedule, c *Calendars, t time.Time, sup []Suppressed) (time.Time, []Suppressed, error) {
+	for i := 0; i < maxSuppressed; i++ {
+		ex, ok := c.Excludes(t)
+		if !ok {
+			return t, sup, nil
+		}
+		sup = addSuppressed(sup, ex, t)
+		n, err := Next(sc, t)
+		if err != nil {
+			return time.Time{}, nil, err
+		}
+		t = n
+	}
+	return time.Time{}, nil, fmt.Errorf("calendars exclude the next %d occurrences", maxSuppressed)
+}
+
+// NextAllowed is Next skipping occurrences the calendars exclude.
+func NextAllowed(sc jobs.Schedule, c *Calendars, from time.Time) (time.Time, []Suppressed, error) {
+	t, err := Next(sc, from)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	return skipExcluded(sc, c, t, nil)
+}
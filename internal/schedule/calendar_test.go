@@ -0,0 +1,174 @@
+package schedule
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rishansujesh/job-scheduler/internal/jobs"
+)
+
+// finance excludes Christmas 2025 and Sundays 02:00-06:00, New York time.
+func finance(t *testing.T) *Calendars {
+	t.Helper()
+	c, err := CompileCalendars([]jobs.Calendar{{
+		ID: "cal", Name: "finance", Timezone: "America/New_York",
+		Dates:   []jobs.CalendarDate{{Day: time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC), Label: "Christmas Day"}},
+		Windows: []jobs.CalendarWindow{{CronExpr: "0 2 * * SUN", DurationSeconds: 4 * 3600, Label: "maintenance"}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestCalendars_Excludes(t *testing.T) {
+	c := finance(t)
+	ny, _ := time.LoadLocation("America/New_York")
+	cases := []struct {
+		at     time.Time
+		reason string
+	}{
+		{time.Date(2025, 12, 25, 0, 0, 0, 0, ny), `date 2025-12-25 "Christmas Day"`},
+		{time.Date(2025, 12, 25, 23, 59, 0, 0, ny), `date 2025-12-25 "Christmas Day"`},
+		{time.Date(2025, 12, 26, 1, 0, 0, 0, time.UTC), `date 2025-12-25 "Christmas Day"`}, // still the 25th in New York
+		{time.Date(2025, 12, 26, 9, 0, 0, 0, ny), ""},
+		{time.Date(2025, 12, 28, 1, 59, 0, 0, ny), ""}, // Sunday
+		{time.Date(2025, 12, 28, 2, 0, 0, 0, ny), `window 0 2 * * SUN "maintenance"`},
+		{time.Date(2025, 12, 28, 5, 59, 0, 0, ny), `window 0 2 * * SUN "maintenance"`},
+		{time.Date(2025, 12, 28, 6, 0, 0, 0, ny), ""},
+	}
+	for _, tc := range cases {
+		ex, ok := c.Excludes(tc.at)
+		if ok != (tc.reason != "") || ex.Reason != tc.reason {
+			t.Errorf("%s: got %q (%v), want %q", tc.at, ex.Reason, ok, tc.reason)
+		}
+	}
+	var none *Calendars
+	if _, ok := none.Excludes(time.Now()); ok {
+		t.Errorf("nil calendars excluded a time")
+	}
+}
+
+func TestNextAllowed_SkipsExclusions(t *testing.T) {
+	cron := "0 * * * *"
+	sc := jobs.Schedule{CronExpr: &cron, Timezone: "America/New_York"}
+	ny, _ := time.LoadLocation("America/New_York")
+	next, sup, err := NextAllowed(sc, finance(t), time.Date(2025, 12, 28, 1, 30, 0, 0, ny))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2025, 12, 28, 6, 0, 0, 0, ny); !next.Equal(want) {
+		t.Fatalf("next = %v, want %v", next, want)
+	}
+	if len(sup) != 1 || sup[0].Count != 4 || sup[0].CalendarID != "cal" {
+		t.Fatalf("suppressed %+v", sup)
+	}
+}
+
+func TestPlanDue_SuppressesExcluded(t *testing.T) {
+	cron := "0 9 * * *"
+	ny, _ := time.LoadLocation("America/New_York")
+	sc := jobs.Schedule{
+		CronExpr: &cron, Timezone: "America/New_York", NextRunAt: time.Date(2025, 12, 25, 9, 0, 0, 0, ny),
+		MisfirePolicy: jobs.MisfireFireOnceNow, MisfireGraceSeconds: 60,
+	}
+	p, err := PlanDue(sc, finance(t), sc.NextRunAt.Add(time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Fire) != 0 || p.Missed.Count != 0 || len(p.Suppressed) != 1 {
+		t.Fatalf("got %+v", p)
+	}
+	if want := time.Date(2025, 12, 26, 9, 0, 0, 0, ny); !p.Next.Equal(want) {
+		t.Fatalf("next = %v, want %v", p.Next, want)
+	}
+}
+
+func TestCompileCalendars_RejectsBadWindows(t *testing.T) {
+	for _, w := range []jobs.CalendarWindow{
+		{CronExpr: "0 2 * * FUNDAY", DurationSeconds: 60},
+		{CronExpr: "@every 1h", DurationSeconds: 60},
+		{CronExpr: "0 2 * * SUN", DurationSeconds: 0},
+	} {
+		if _, err := CompileCalendars([]jobs.Calendar{{Name: "c", Windows: []jobs.CalendarWindow{w}}}); err == nil {
+			t.Errorf("%+v: want error", w)
+		}
+	}
+}
+
+func TestParseICS(t *testing.T) {
+	ics := "BEGIN:VCALENDAR\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"DTSTART;VALUE=DATE:20251225\r\n" +
+		"DTEND;VALUE=DATE:20251227\r\n" +
+		"SUMMARY:Christmas\\, Boxing\r\n" +
+		"  Day\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"DTSTART:20260101T000000Z\r\n" +
+		"SUMMARY:New Year\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+	dates, err := ParseICS(ics, time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, d := range dates {
+		got = append(got, d.Day.Format(time.DateOnly)+" "+d.Label)
+	}
+	want := []string{"2025-12-25 Christmas, Boxing Day", "2025-12-26 Christmas, Boxing Day", "2026-01-01 New Year"}
+	if len(got) != len(want) {
+		t.Fatalf("got %q", got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("got %q, want %q", got[i], want[i])
+		}
+	}
+
+	if _, err := ParseICS("BEGIN:VEVENT\nDTSTART:20250101\nRRULE:FREQ=YEARLY\nEND:VEVENT\n", time.UTC); err == nil {
+		t.Errorf("want error for RRULE")
+	}
+}
+
+func TestParseICS_TimedEventsTakeTheCalendarsDate(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ics := "BEGIN:VEVENT\r\n" +
+		"DTSTART:20261231T230000Z\r\n" +
+		"SUMMARY:Late UTC\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"DTSTART;TZID=America/New_York:20260301T200000\r\n" +
+		"DTEND;TZID=America/New_York:20260302T100000\r\n" +
+		"SUMMARY:Overnight\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"DTSTART:20260315T090000\r\n" +
+		"DTEND:20260316T000000\r\n" +
+		"SUMMARY:Floating\r\n" +
+		"END:VEVENT\r\n"
+	dates, err := ParseICS(ics, tokyo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, d := range dates {
+		got = append(got, d.Day.Format(time.DateOnly)+" "+d.Label)
+	}
+	// 23:00 UTC is 08:00 the next day in Tokyo; 20:00 to 10:00 in New
+	// York is 10:00 on the 2nd to 00:00 on the 3rd there; a floating
+	// time is Tokyo's and an end at midnight does not cover that date
+	want := []string{"2027-01-01 Late UTC", "2026-03-02 Overnight", "2026-03-15 Floating"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+
+	if _, err := ParseICS("BEGIN:VEVENT\nDTSTART;TZID=Nowhere/Else:20260101T090000\nEND:VEVENT\n", tokyo); err == nil {
+		t.Errorf("want error for unknown TZID")
+	}
+}
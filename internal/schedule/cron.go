@@ -4,31 +4,30 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/robfig/cron/v3"
+	"github.com/rishansujesh/job-scheduler/internal/jobs"
 )
 
 // NextRun computes the next run time given either a cron expression OR fixed interval seconds.
-// "from" is the reference time; timezone is applied if provided.
+// "from" is the reference time; timezone is applied if provided. Fixed
+// intervals are elapsed time; see dst.go for how crons treat DST changes.
 func NextRun(cronExpr *string, fixedIntervalSeconds *int, from time.Time, timezone string) (time.Time, error) {
-	loc := time.UTC
-	if timezone != "" {
-		l, err := time.LoadLocation(timezone)
-		if err != nil {
-			return time.Time{}, fmt.Errorf("invalid timezone: %w", err)
-		}
-		loc = l
+	loc, err := location(timezone)
+	if err != nil {
+		return time.Time{}, err
 	}
 	ref := from.In(loc)
 
 	switch {
 	case cronExpr != nil && *cronExpr != "":
-		// Standard 5-field cron parser (minute hour dom month dow)
-		parser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
-		sched, err := parser.Parse(*cronExpr)
+		sched, err := ParseCron(*cronExpr)
 		if err != nil {
 			return time.Time{}, fmt.Errorf("invalid cron: %w", err)
 		}
-		return sched.Next(ref), nil
+		next := nextCron(sched, ref, loc)
+		if next.IsZero() {
+			return time.Time{}, fmt.Errorf("cron %q never fires", *cronExpr)
+		}
+		return next, nil
 
 	case fixedIntervalSeconds != nil && *fixedIntervalSeconds > 0:
 		return ref.Add(time.Duration(*fixedIntervalSeconds) * time.Second), nil
@@ -37,3 +36,30 @@ func NextRun(cronExpr *string, fixedIntervalSeconds *int, from time.Time, timezo
 		return time.Time{}, fmt.Errorf("no schedule provided")
 	}
 }
+
+// Next is NextRun for a stored schedule, honouring its interval mode.
+func Next(sc jobs.Schedule, from time.Time) (time.Time, error) {
+	wall := sc.IntervalMode == jobs.IntervalWallClock &&
+		(sc.CronExpr == nil || *sc.CronExpr == "") &&
+		sc.FixedIntervalSeconds != nil && *sc.FixedIntervalSeconds > 0
+	if !wall {
+		return NextRun(sc.CronExpr, sc.FixedIntervalSeconds, from, sc.Timezone)
+	}
+	loc, err := location(sc.Timezone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	every := time.Duration(*sc.FixedIntervalSeconds) * time.Second
+	return nextWallInterval(sc.IntervalAnchor, every, from, loc), nil
+}
+
+func location(timezone string) (*time.Location, error) {
+	if timezone == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone: %w", err)
+	}
+	return loc, nil
+}
@@ -0,0 +1,118 @@
+package schedule
+
+import (
+	"sort"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// DST semantics
+//
+// Cron expressions and wall_clock intervals are evaluated on the local wall
+// clock of the schedule's timezone, and each wall time is then mapped to an
+// instant:
+//
+//   - a wall time skipped by a spring-forward change runs at the shifted
+//     time: with clocks jumping from 02:00 to 03:00, 02:30 runs at 03:30;
+//   - a wall time repeated by a fall-back change runs once, on its first
+//     occurrence: 01:30 runs at 01:30 daylight time, not again an hour later.
+//
+// Elapsed intervals and @every add real time and ignore DST.
+
+// wallTime returns t's local clock reading in loc as a UTC time. UTC has no
+// transitions, so cron arithmetic on it never skips or repeats a time.
+func wallTime(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// zoneTimes returns the instants at which loc's clocks show wall time w,
+// earliest first: two in a repeated hour, none in a skipped one.
+func zoneTimes(w time.Time, loc *time.Location) []time.Time {
+	// the offsets either side of w cover any transition near it
+	_, before := w.Add(-36 * time.Hour).In(loc).Zone()
+	_, after := w.Add(36 * time.Hour).In(loc).Zone()
+
+	var at []time.Time
+	for _, off := range []int{before, after} {
+		t := w.Add(-time.Duration(off) * time.Second)
+		if _, o := t.In(loc).Zone(); o == off && (len(at) == 0 || !at[0].Equal(t)) {
+			at = append(at, t)
+		}
+	}
+	sort.Slice(at, func(i, j int) bool { return at[i].Before(at[j]) })
+	return at
+}
+
+// resolve returns the first instant after from at which loc's clocks show
+// wall time w, following the rules above.
+func resolve(w time.Time, loc *time.Location, from time.Time) (time.Time, bool) {
+	at := zoneTimes(w, loc)
+	if len(at) == 0 {
+		// skipped: read w with the offset from before the change
+		_, before := w.Add(-36 * time.Hour).In(loc).Zone()
+		at = append(at, w.Add(-time.Duration(before)*time.Second))
+	}
+	for _, t := range at {
+		if t.After(from) {
+			return t.In(loc), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// wallSchedule returns a copy of s that runs on wall time (in UTC) and the
+// zone it belongs to; a TZ= prefix in the expression overrides loc. It
+// returns nil for schedules that are not tied to the clock, like @every.
+func wallSchedule(s cron.Schedule, loc *time.Location) (cron.Schedule, *time.Location) {
+	switch s := s.(type) {
+	case *cron.SpecSchedule:
+		c := *s
+		if c.Location != time.Local {
+			loc = c.Location
+		}
+		c.Location = time.UTC
+		return &c, loc
+	case *extSchedule:
+		base, loc := wallSchedule(s.base, loc)
+		if base == nil {
+			return nil, loc
+		}
+		return &extSchedule{base: base, dom: s.dom, dow: s.dow}, loc
+	}
+	return nil, loc
+}
+
+// nextCron returns sched's first occurrence after from in loc.
+func nextCron(sched cron.Schedule, from time.Time, loc *time.Location) time.Time {
+	ws, loc := wallSchedule(sched, loc)
+	if ws == nil {
+		return sched.Next(from)
+	}
+	w := wallTime(from, loc)
+	for {
+		if w = ws.Next(w); w.IsZero() {
+			return w
+		}
+		// only a repeated wall time can resolve to the past; the next one
+		// is later
+		if t, ok := resolve(w, loc, from); ok {
+			return t
+		}
+	}
+}
+
+// nextWallInterval returns the first wall time anchor + k*every after from.
+func nextWallInterval(anchor time.Time, every time.Duration, from time.Time, loc *time.Location) time.Time {
+	a, wf := wallTime(anchor, loc), wallTime(from, loc)
+	k := time.Duration(0)
+	if !wf.Before(a) {
+		k = wf.Sub(a)/every + 1
+	}
+	for ; ; k++ {
+		if t, ok := resolve(a.Add(k*every), loc, from); ok {
+			return t
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rishansujesh/job-scheduler/internal/jobs"
+)
+
+func rfc(t *testing.T, s string) time.Time {
+	t.Helper()
+	v, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func TestNext_DST(t *testing.T) {
+	cases := []struct {
+		name     string
+		zone     string
+		cron     string
+		interval int
+		mode     jobs.IntervalMode
+		from     string // also the anchor of wall_clock intervals
+		want     []string
+	}{
+		// America/New_York: 2025-03-09 02:00 -> 03:00, 2025-11-02 02:00 -> 01:00
+		{name: "ny skipped", zone: "America/New_York", cron: "30 2 * * *", from: "2025-03-08T12:00:00-05:00",
+			want: []string{"2025-03-09T03:30:00-04:00", "2025-03-10T02:30:00-04:00"}},
+		{name: "ny repeated", zone: "America/New_York", cron: "30 1 * * *", from: "2025-11-01T12:00:00-04:00",
+			want: []string{"2025-11-02T01:30:00-04:00", "2025-11-03T01:30:00-05:00"}},
+		{name: "ny hourly spring", zone: "America/New_York", cron: "0 * * * *", from: "2025-03-09T00:30:00-05:00",
+			want: []string{"2025-03-09T01:00:00-05:00", "2025-03-09T03:00:00-04:00", "2025-03-09T04:00:00-04:00"}},
+		{name: "ny hourly fall", zone: "America/New_York", cron: "0 * * * *", from: "2025-11-02T00:30:00-04:00",
+			want: []string{"2025-11-02T01:00:00-04:00", "2025-11-02T02:00:00-05:00", "2025-11-02T03:00:00-05:00"}},
+		{name: "ny from second pass", zone: "America/New_York", cron: "*/30 * * * *", from: "2025-11-02T01:15:00-05:00",
+			want: []string{"2025-11-02T01:30:00-05:00", "2025-11-02T02:00:00-05:00"}},
+		{name: "ny quartz", zone: "America/New_York", cron: "30 2 * * SUN#2", from: "2025-03-01T00:00:00-05:00",
+			want: []string{"2025-03-09T03:30:00-04:00", "2025-04-13T02:30:00-04:00"}},
+		{name: "tz prefix", zone: "UTC", cron: "TZ=America/New_York 30 2 * * *", from: "2025-03-08T12:00:00Z",
+			want: []string{"2025-03-09T03:30:00-04:00"}},
+
+		// Europe/London: 2025-03-30 01:00 -> 02:00, 2025-10-26 02:00 -> 01:00
+		{name: "london skipped", zone: "Europe/London", cron: "30 1 * * *", from: "2025-03-29T12:00:00Z",
+			want: []string{"2025-03-30T02:30:00+01:00", "2025-03-31T01:30:00+01:00"}},
+		{name: "london repeated", zone: "Europe/London", cron: "30 1 * * *", from: "2025-10-25T12:00:00+01:00",
+			want: []string{"2025-10-26T01:30:00+01:00", "2025-10-27T01:30:00Z"}},
+
+		// Europe/Berlin: 2025-03-30 02:00 -> 03:00, 2025-10-26 03:00 -> 02:00
+		{name: "berlin skipped", zone: "Europe/Berlin", cron: "30 2 * * *", from: "2025-03-29T12:00:00+01:00",
+			want: []string{"2025-03-30T03:30:00+02:00", "2025-03-31T02:30:00+02:00"}},
+		{name: "berlin repeated", zone: "Europe/Berlin", cron: "30 2 * * *", from: "2025-10-25T12:00:00+02:00",
+			want: []string{"2025-10-26T02:30:00+02:00", "2025-10-27T02:30:00+01:00"}},
+
+		// Australia/Sydney: 2025-04-06 03:00 -> 02:00, 2025-10-05 02:00 -> 03:00
+		{name: "sydney repeated", zone: "Australia/Sydney", cron: "30 2 * * *", from: "2025-04-05T12:00:00+11:00",
+			want: []string{"2025-04-06T02:30:00+11:00", "2025-04-07T02:30:00+10:00"}},
+		{name: "sydney skipped", zone: "Australia/Sydney", cron: "30 2 * * *", from: "2025-10-04T12:00:00+10:00",
+			want: []string{"2025-10-05T03:30:00+11:00", "2025-10-06T02:30:00+11:00"}},
+
+		// Australia/Lord_Howe shifts by 30 minutes: 2025-10-05 02:00 -> 02:30
+		{name: "lord howe skipped", zone: "Australia/Lord_Howe", cron: "15 2 * * *", from: "2025-10-04T12:00:00+10:30",
+			want: []string{"2025-10-05T02:45:00+11:00", "2025-10-06T02:15:00+11:00"}},
+
+		// fixed intervals
+		{name: "elapsed daily", zone: "America/New_York", interval: 86400, from: "2025-03-08T09:00:00-05:00",
+			want: []string{"2025-03-09T10:00:00-04:00", "2025-03-10T10:00:00-04:00"}},
+		{name: "wall clock daily", zone: "America/New_York", interval: 86400, mode: jobs.IntervalWallClock, from: "2025-03-08T09:00:00-05:00",
+			want: []string{"2025-03-09T09:00:00-04:00", "2025-03-10T09:00:00-04:00"}},
+		{name: "wall clock skipped", zone: "America/New_York", interval: 86400, mode: jobs.IntervalWallClock, from: "2025-03-08T02:30:00-05:00",
+			want: []string{"2025-03-09T03:30:00-04:00", "2025-03-10T02:30:00-04:00"}},
+		{name: "wall clock hourly fall", zone: "America/New_York", interval: 3600, mode: jobs.IntervalWallClock, from: "2025-11-02T00:00:00-04:00",
+			want: []string{"2025-11-02T01:00:00-04:00", "2025-11-02T02:00:00-05:00"}},
+		{name: "wall clock repeated", zone: "Europe/Berlin", interval: 86400, mode: jobs.IntervalWallClock, from: "2025-10-25T02:30:00+02:00",
+			want: []string{"2025-10-26T02:30:00+02:00", "2025-10-27T02:30:00+01:00"}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			from := rfc(t, c.from)
+			sc := jobs.Schedule{Timezone: c.zone, IntervalMode: c.mode, IntervalAnchor: from}
+			if c.cron != "" {
+				sc.CronExpr = &c.cron
+			} else {
+				sc.FixedIntervalSeconds = &c.interval
+			}
+			at := from
+			for _, w := range c.want {
+				next, err := Next(sc, at)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if want := rfc(t, w); !next.Equal(want) {
+					t.Fatalf("after %s: got %s, want %s", at.Format(time.RFC3339), next.Format(time.RFC3339), w)
+				}
+				at = next
+			}
+		})
+	}
+}
@@ -0,0 +1,265 @@
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// parser accepts 5-field crons, 6-field crons with a leading seconds field,
+// and descriptors (@hourly, @every 90s, ...).
+var parser = cron.NewParser(cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// ParseCron parses a cron expression. On top of what robfig/cron accepts it
+// supports the Quartz day extensions:
+//
+//	day of month: L (last day), LW (last weekday), 15W (weekday nearest the 15th)
+//	day of week:  5L or FRIL (last Friday), MON#2 (second Monday)
+//
+// As in plain cron, a restricted day of month and day of week match if
+// either does.
+func ParseCron(expr string) (cron.Schedule, error) {
+	expr = strings.TrimSpace(expr)
+	fields := strings.Fields(expr)
+	var tz string
+	if len(fields) > 0 && (strings.HasPrefix(fields[0], "TZ=") || strings.HasPrefix(fields[0], "CRON_TZ=")) {
+		tz, fields = fields[0], fields[1:]
+	}
+	if strings.HasPrefix(expr, "@") || (len(fields) != 5 && len(fields) != 6) || !extended(fields) {
+		return parser.Parse(expr)
+	}
+
+	domIdx, dowIdx := len(fields)-3, len(fields)-1
+	dom, err := parseDays(fields[domIdx], domItem)
+	if err != nil {
+		return nil, fmt.Errorf("day of month %q: %w", fields[domIdx], err)
+	}
+	dow, err := parseDays(fields[dowIdx], dowItem)
+	if err != nil {
+		return nil, fmt.Errorf("day of week %q: %w", fields[dowIdx], err)
+	}
+
+	// robfig handles the time-of-day and month fields; days are filtered here.
+	base := append([]string(nil), fields...)
+	base[domIdx], base[dowIdx] = "*", "*"
+	if tz != "" {
+		base = append([]string{tz}, base...)
+	}
+	sched, err := parser.Parse(strings.Join(base, " "))
+	if err != nil {
+		return nil, err
+	}
+	return &extSchedule{base: sched, dom: dom, dow: dow}, nil
+}
+
+// extended reports whether the day fields use L, W or #.
+func extended(fields []string) bool {
+	dom := strings.ToUpper(fields[len(fields)-3])
+	dow := strings.ToUpper(fields[len(fields)-1])
+	if strings.ContainsAny(dom, "LW") || strings.Contains(dow, "#") {
+		return true
+	}
+	for _, item := range strings.Split(dow, ",") {
+		if len(item) > 1 && strings.HasSuffix(item, "L") {
+			return true
+		}
+	}
+	return false
+}
+
+// dayMatcher reports whether a date matches one day field; nil means "*".
+type dayMatcher func(t time.Time) bool
+
+type extSchedule struct {
+	base     cron.Schedule // fires on every day
+	dom, dow dayMatcher
+}
+
+// maxDays bounds the search, so impossible specs (e.g. 30W in February only)
+// return the zero time instead of looping forever.
+const maxDays = 5 * 366
+
+func (s *extSchedule) Next(t time.Time) time.Time {
+	for i := 0; i < maxDays; i++ {
+		c := s.base.Next(t)
+		if c.IsZero() || s.dayMatches(c) {
+			return c
+		}
+		// skip the rest of c's day
+		t = time.Date(c.Year(), c.Month(), c.Day(), 23, 59, 59, 0, c.Location())
+	}
+	return time.Time{}
+}
+
+func (s *extSchedule) dayMatches(t time.Time) bool {
+	switch {
+	case s.dom == nil && s.dow == nil:
+		return true
+	case s.dom == nil:
+		return s.dow(t)
+	case s.dow == nil:
+		return s.dom(t)
+	default:
+		return s.dom(t) || s.dow(t)
+	}
+}
+
+// parseDays builds a matcher from a comma-separated day field.
+func parseDays(field string, item func(string) (dayMatcher, error)) (dayMatcher, error) {
+	if field == "*" || field == "?" {
+		return nil, nil
+	}
+	var ms []dayMatcher
+	for _, it := range strings.Split(strings.ToUpper(field), ",") {
+		m, err := item(it)
+		if err != nil {
+			return nil, err
+		}
+		ms = append(ms, m)
+	}
+	return func(t time.Time) bool {
+		for _, m := range ms {
+			if m(t) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+func domItem(it string) (dayMatcher, error) {
+	switch {
+	case it == "L":
+		return func(t time.Time) bool { return t.Day() == lastDay(t) }, nil
+	case it == "LW":
+		return func(t time.Time) bool { return t.Day() == nearestWeekday(t, lastDay(t)) }, nil
+	case strings.HasSuffix(it, "W"):
+		n, err := strconv.Atoi(strings.TrimSuffix(it, "W"))
+		if err != nil || n < 1 || n > 31 {
+			return nil, fmt.Errorf("bad W item %q", it)
+		}
+		return func(t time.Time) bool { return n <= lastDay(t) && t.Day() == nearestWeekday(t, n) }, nil
+	}
+	in, err := rangeItem(it, 1, 31, nil)
+	if err != nil {
+		return nil, err
+	}
+	return func(t time.Time) bool { return in(t.Day()) }, nil
+}
+
+var dowNames = map[string]int{"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6}
+
+func dowItem(it string) (dayMatcher, error) {
+	if day, nth, ok := strings.Cut(it, "#"); ok {
+		d, err := weekday(day)
+		if err != nil {
+			return nil, err
+		}
+		k, err := strconv.Atoi(nth)
+		if err != nil || k < 1 || k > 5 {
+			return nil, fmt.Errorf("bad # item %q", it)
+		}
+		return func(t time.Time) bool { return int(t.Weekday()) == d && (t.Day()-1)/7+1 == k }, nil
+	}
+	if len(it) > 1 && strings.HasSuffix(it, "L") {
+		d, err := weekday(strings.TrimSuffix(it, "L"))
+		if err != nil {
+			return nil, err
+		}
+		return func(t time.Time) bool { return int(t.Weekday()) == d && t.Day()+7 > lastDay(t) }, nil
+	}
+	in, err := rangeItem(it, 0, 7, dowNames)
+	if err != nil {
+		return nil, err
+	}
+	return func(t time.Time) bool {
+		wd := int(t.Weekday())
+		return in(wd) || (wd == 0 && in(7))
+	}, nil
+}
+
+func weekday(s string) (int, error) {
+	if n, ok := dowNames[s]; ok {
+		return n, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 || n > 7 {
+		return 0, fmt.Errorf("bad weekday %q", s)
+	}
+	return n % 7, nil
+}
+
+// rangeItem parses "*", "n", "a-b" with an optional "/step".
+func rangeItem(it string, min, max int, names map[string]int) (func(int) bool, error) {
+	num := func(s string) (int, error) {
+		if n, ok := names[s]; ok {
+			return n, nil
+		}
+		n, err := strconv.Atoi(s)
+		if err != nil || n < min || n > max {
+			return 0, fmt.Errorf("bad value %q (want %d-%d)", s, min, max)
+		}
+		return n, nil
+	}
+	rng, stepS, hasStep := strings.Cut(it, "/")
+	step := 1
+	if hasStep {
+		n, err := strconv.Atoi(stepS)
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("bad step %q", it)
+		}
+		step = n
+	}
+	lo, hi := min, max
+	switch {
+	case rng == "*":
+	case strings.Contains(rng, "-"):
+		a, b, _ := strings.Cut(rng, "-")
+		var err error
+		if lo, err = num(a); err != nil {
+			return nil, err
+		}
+		if hi, err = num(b); err != nil {
+			return nil, err
+		}
+		if lo > hi {
+			return nil, fmt.Errorf("bad range %q", rng)
+		}
+	default:
+		n, err := num(rng)
+		if err != nil {
+			return nil, err
+		}
+		lo = n
+		if !hasStep {
+			hi = n
+		}
+	}
+	return func(v int) bool { return v >= lo && v <= hi && (v-lo)%step == 0 }, nil
+}
+
+func lastDay(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// nearestWeekday returns the weekday closest to day n of t's month without
+// leaving the month (Quartz "W" semantics).
+func nearestWeekday(t time.Time, n int) int {
+	d := time.Date(t.Year(), t.Month(), n, 0, 0, 0, 0, time.UTC)
+	switch d.Weekday() {
+	case time.Saturday:
+		if n == 1 {
+			return 3 // Monday
+		}
+		return n - 1
+	case time.Sunday:
+		if n == lastDay(t) {
+			return n - 2 // Friday
+		}
+		return n + 1
+	}
+	return n
+}
@@ -0,0 +1,73 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCron_Next(t *testing.T) {
+	from := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC) // Friday
+	cases := []struct {
+		expr string
+		want time.Time
+	}{
+		{"*/5 * * * *", time.Date(2025, 1, 10, 12, 5, 0, 0, time.UTC)},
+		{"*/15 * * * * *", time.Date(2025, 1, 10, 12, 0, 15, 0, time.UTC)},
+		{"@hourly", time.Date(2025, 1, 10, 13, 0, 0, 0, time.UTC)},
+		{"@every 90s", time.Date(2025, 1, 10, 12, 1, 30, 0, time.UTC)},
+		{"0 9 L * *", time.Date(2025, 1, 31, 9, 0, 0, 0, time.UTC)},
+		{"0 9 LW * *", time.Date(2025, 1, 31, 9, 0, 0, 0, time.UTC)},
+		{"0 9 LW 5 *", time.Date(2025, 5, 30, 9, 0, 0, 0, time.UTC)},    // May 31 2025 is a Saturday
+		{"0 9 1W 2 *", time.Date(2025, 2, 3, 9, 0, 0, 0, time.UTC)},     // Feb 1 is a Saturday: not back into January
+		{"0 9 15W 6 *", time.Date(2025, 6, 16, 9, 0, 0, 0, time.UTC)},   // Jun 15 is a Sunday
+		{"0 9 * * MON#2", time.Date(2025, 1, 13, 9, 0, 0, 0, time.UTC)}, // second Monday of January
+		{"0 9 * * 1#1", time.Date(2025, 2, 3, 9, 0, 0, 0, time.UTC)},
+		{"0 9 * * FRIL", time.Date(2025, 1, 31, 9, 0, 0, 0, time.UTC)},
+		{"0 9 * * 5L", time.Date(2025, 1, 31, 9, 0, 0, 0, time.UTC)},
+		{"30 0 9 L 2 *", time.Date(2025, 2, 28, 9, 0, 30, 0, time.UTC)}, // with seconds
+		{"0 9 L * MON", time.Date(2025, 1, 13, 9, 0, 0, 0, time.UTC)},   // OR, like plain cron
+	}
+	for _, c := range cases {
+		s, err := ParseCron(c.expr)
+		if err != nil {
+			t.Errorf("%s: %v", c.expr, err)
+			continue
+		}
+		if got := s.Next(from); !got.Equal(c.want) {
+			t.Errorf("%s: Next = %v, want %v", c.expr, got, c.want)
+		}
+	}
+}
+
+func TestParseCron_LeapYear(t *testing.T) {
+	s, err := ParseCron("0 0 L 2 *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := s.Next(time.Date(2027, 6, 1, 0, 0, 0, 0, time.UTC))
+	if want := time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Fatalf("got %v want %v", got, want)
+	}
+}
+
+func TestParseCron_Invalid(t *testing.T) {
+	for _, expr := range []string{
+		"0 9 32W * *",
+		"0 9 * * MON#6",
+		"0 9 * * XYZL",
+		"0 9 L * * * *",
+		"@fortnightly",
+		"61 * * * *",
+	} {
+		if _, err := ParseCron(expr); err == nil {
+			t.Errorf("%s: want error", expr)
+		}
+	}
+}
+
+func TestNextRun_NeverFires(t *testing.T) {
+	expr := "0 0 30W 2 *"
+	if _, err := NextRun(&expr, nil, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), "UTC"); err == nil {
+		t.Fatalf("want error for a cron that never fires")
+	}
+}
@@ -0,0 +1,51 @@
+package schedule
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+// ErrStaleLeader means a leader with a larger fencing token has written
+// since; the caller is no longer leader and must not write.
+var ErrStaleLeader = errors.New("stale leader: fencing token superseded")
+
+// ClaimFence records token as the current leader's for the election name.
+// It fails with ErrStaleLeader if a larger token was claimed already.
+func ClaimFence(ctx context.Context, db *sql.DB, name string, token int64) error {
+	res, err := db.ExecContext(ctx, `
+INSERT INTO scheduler_fence (name, token) VALUES ($1, $2)
+ON CONFLICT (name) DO UPDATE SET token = EXCLUDED.token
+WHERE scheduler_fence.token <= EXCLUDED.token`, name, token)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("%w (token %d)", ErrStaleLeader, token)
+	}
+	return nil
+}
+
+// FenceToken returns the token claimed for the election name, or 0 if none
+// was.
+func FenceToken(ctx context.Context, db *sql.DB, name string) (int64, error) {
+	var token int64
+	err := db.QueryRowContext(ctx, `SELECT token FROM scheduler_fence WHERE name = $1`, name).Scan(&token)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	return token, err
+}
+
+// CheckFence fails with ErrStaleLeader unless token is still the claimed
+// one. The shared row lock it takes makes a new leader's ClaimFence wait for
+// the caller's transaction, so no write of an old term commits after it.
+func CheckFence(ctx context.Context, tx *sql.Tx, name string, token int64) error {
+	var cur int64
+	err := tx.QueryRowContext(ctx, `SELECT token FROM scheduler_fence WHERE name = $1 FOR SHARE`, name).Scan(&cur)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && cur != token) {
+		return fmt.Errorf("%w (token %d)", ErrStaleLeader, token)
+	}
+	return err
+}
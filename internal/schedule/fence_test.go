@@ -0,0 +1,51 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestClaimFence_RejectsOlderToken(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	mock.ExpectExec(`INSERT INTO scheduler_fence`).WithArgs("leader", int64(4)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO scheduler_fence`).WithArgs("leader", int64(3)).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := ClaimFence(context.Background(), db, "leader", 4); err != nil {
+		t.Fatal(err)
+	}
+	if err := ClaimFence(context.Background(), db, "leader", 3); !errors.Is(err, ErrStaleLeader) {
+		t.Fatalf("want ErrStaleLeader, got %v", err)
+	}
+}
+
+func TestCheckFence(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT token FROM scheduler_fence WHERE name = \$1 FOR SHARE`).WithArgs("leader").
+		WillReturnRows(sqlmock.NewRows([]string{"token"}).AddRow(5))
+	mock.ExpectQuery(`FROM scheduler_fence`).WithArgs("leader").
+		WillReturnRows(sqlmock.NewRows([]string{"token"}).AddRow(5))
+
+	ctx := context.Background()
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckFence(ctx, tx, "leader", 5); err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckFence(ctx, tx, "leader", 4); !errors.Is(err, ErrStaleLeader) {
+		t.Fatalf("want ErrStaleLeader, got %v", err)
+	}
+}
@@ -0,0 +1,167 @@
+package schedule
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rishansujesh/job-scheduler/internal/jobs"
+)
+
+// maxEventDays bounds how many days one all-day event may cover.
+const maxEventDays = 366
+
+// ParseICS reads the events of an iCalendar file (RFC 5545) as excluded
+// dates, labelled with their SUMMARY. All-day events cover DTSTART up to but
+// not including DTEND; timed events exclude the dates they touch in loc, the
+// calendar's timezone. Recurring events (RRULE) are rejected: holiday feeds
+// list each date.
+func ParseICS(data string, loc *time.Location) ([]jobs.CalendarDate, error) {
+	var (
+		out     []jobs.CalendarDate
+		inEvent bool
+		ev      map[string]string
+	)
+	lines := unfold(data)
+	for n, line := range lines {
+		name, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		name, params, _ := strings.Cut(name, ";")
+		name = strings.ToUpper(name)
+		switch {
+		case name == "BEGIN" && strings.EqualFold(value, "VEVENT"):
+			inEvent, ev = true, map[string]string{}
+		case name == "END" && strings.EqualFold(value, "VEVENT"):
+			if !inEvent {
+				return nil, fmt.Errorf("line %d: END:VEVENT without BEGIN", n+1)
+			}
+			inEvent = false
+			dates, err := eventDates(ev, loc)
+			if err != nil {
+				return nil, fmt.Errorf("event %q: %w", ev["SUMMARY"], err)
+			}
+			out = append(out, dates...)
+		case inEvent:
+			ev[name] = strings.TrimSpace(value)
+			if tzid := icsParam(params, "TZID"); tzid != "" {
+				ev[name+";TZID"] = tzid
+			}
+		}
+	}
+	if inEvent {
+		return nil, fmt.Errorf("unterminated VEVENT")
+	}
+	return out, nil
+}
+
+// unfold joins continuation lines (starting with a space or tab).
+func unfold(data string) []string {
+	var lines []string
+	sc := bufio.NewScanner(strings.NewReader(data))
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for sc.Scan() {
+		l := strings.TrimRight(sc.Text(), "\r")
+		if (strings.HasPrefix(l, " ") || strings.HasPrefix(l, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += l[1:]
+			continue
+		}
+		lines = append(lines, l)
+	}
+	return lines
+}
+
+func eventDates(ev map[string]string, loc *time.Location) ([]jobs.CalendarDate, error) {
+	if _, ok := ev["RRULE"]; ok {
+		return nil, fmt.Errorf("recurring events are not supported")
+	}
+	start, allDay, err := icsDate(ev["DTSTART"], ev["DTSTART;TZID"], loc)
+	if err != nil {
+		return nil, fmt.Errorf("DTSTART: %w", err)
+	}
+	label := unescapeText(ev["SUMMARY"])
+	end := start.AddDate(0, 0, 1)
+	if v, ok := ev["DTEND"]; ok {
+		last, _, err := icsDate(v, ev["DTEND;TZID"], loc)
+		if err != nil {
+			return nil, fmt.Errorf("DTEND: %w", err)
+		}
+		if !allDay {
+			// a timed event covers the date it ends on too, unless it
+			// ends at midnight
+			t, _ := icsTime(v, ev["DTEND;TZID"], loc)
+			if t.After(last) {
+				last = last.AddDate(0, 0, 1)
+			}
+		}
+		if last.After(start) {
+			end = last
+		}
+	}
+	var out []jobs.CalendarDate
+	for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+		if len(out) == maxEventDays {
+			return nil, fmt.Errorf("event covers more than %d days", maxEventDays)
+		}
+		out = append(out, jobs.CalendarDate{Day: d, Label: label})
+	}
+	return out, nil
+}
+
+// icsDate parses a DATE (20251225) or DATE-TIME (20251225T090000[Z])
+// value and returns its date in loc at midnight UTC. A DATE-TIME is UTC
+// with a Z suffix, in tzid if given, and otherwise already local to loc.
+func icsDate(v, tzid string, loc *time.Location) (time.Time, bool, error) {
+	if len(v) == 8 {
+		d, err := time.Parse("20060102", v)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("bad date %q", v)
+		}
+		return d, true, nil
+	}
+	t, err := icsTime(v, tzid, loc)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC), false, nil
+}
+
+// icsTime parses a DATE-TIME value and returns it in loc; see icsDate.
+func icsTime(v, tzid string, loc *time.Location) (time.Time, error) {
+	in := loc
+	switch {
+	case strings.HasSuffix(v, "Z"):
+		v, in = strings.TrimSuffix(v, "Z"), time.UTC
+	case tzid != "":
+		l, err := time.LoadLocation(tzid)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("unknown TZID %q", tzid)
+		}
+		in = l
+	}
+	t, err := time.ParseInLocation("20060102T150405", v, in)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("bad date %q", v)
+	}
+	return t.In(loc), nil
+}
+
+// icsParam returns the value of the named property parameter, e.g. TZID in
+// "TZID=Europe/Berlin;VALUE=DATE-TIME".
+func icsParam(params, name string) string {
+	for _, p := range strings.Split(params, ";") {
+		if k, v, ok := strings.Cut(p, "="); ok && strings.EqualFold(k, name) {
+			return strings.Trim(v, `"`)
+		}
+	}
+	return ""
+}
+
+var textEscapes = strings.NewReplacer(`\,`, ",", `\;`, ";", `\n`, " ", `\N`, " ", `\\`, `\`)
+
+func unescapeText(s string) string {
+	return textEscapes.Replace(s)
+}
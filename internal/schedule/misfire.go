@@ -0,0 +1,273 @@
+package schedule
+
+import (
+	"time"
+
+	"github.com/rishansujesh/job-scheduler/internal/jobs"
+)
+
+// maxScan bounds how many due occurrences of one schedule are kept; older
+// ones are only counted as missed.
+const maxScan = 10000
+
+// Plan is what the scan loop does with a due schedule.
+type Plan struct {
+	Fire       []time.Time  // occurrences to enqueue now, oldest first
+	Missed     Missed       // occurrences dropped by the misfire policy
+	Suppressed []Suppressed // occurrences excluded by calendars, due or skipped to reach Next
+	Next       time.Time    // new next_run_at, after now and allowed by the calendars
+	Done       bool         // past end_at or max_runs reached: disable the schedule
+}
+
+// Missed summarizes dropped occurrences; Count is 0 if none were dropped.
+type Missed struct {
+	Count       int
+	First, Last time.Time
+}
+
+func (m *Missed) add(t time.Time) {
+	if m.Count == 0 {
+		m.First = t
+	}
+	m.Last = t
+	m.Count++
+}
+
+// PlanDue works out which of the schedule's occurrences up to now to fire.
+// Occurrences before start_at or paused_until are skipped and those after
+// end_at never fire. Occurrences the calendars exclude are suppressed. Of the
+// rest, those within the grace period are on time and always fire; later
+// ones are handled by the schedule's misfire policy. At most max_runs fire
+// over the schedule's lifetime. Far behind, only the latest maxScan due
+// occurrences are candidates to fire; older ones all count as missed.
+func PlanDue(sc jobs.Schedule, cals *Calendars, now time.Time) (Plan, error) {
+	var p Plan
+	var due []time.Time
+	t := sc.NextRunAt
+	if hold := sc.HoldUntil(); t.Before(hold) {
+		var err error
+		if t, err = firstFrom(sc, t, hold); err != nil {
+			return Plan{}, err
+		}
+	}
+	t, missed, err := skipBehind(sc, t, now)
+	if err != nil {
+		return Plan{}, err
+	}
+	p.Missed = missed
+	for !t.After(now) && !pastEnd(sc, t) {
+		if ex, ok := cals.Excludes(t); ok {
+			p.Suppressed = addSuppressed(p.Suppressed, ex, t)
+		} else {
+			if len(due) == maxScan {
+				// far behind (e.g. a seconds cron after a long outage):
+				// keep the latest, so fire_once_now fires the last one
+				p.Missed.add(due[0])
+				due = due[1:]
+			}
+			due = append(due, t)
+		}
+		n, err := Next(sc, t)
+		if err != nil {
+			return Plan{}, err
+		}
+		t = n
+	}
+	if pastEnd(sc, t) {
+		p.Next, p.Done = t, true
+	} else {
+		var err error
+		if p.Next, p.Suppressed, err = skipExcluded(sc, cals, t, p.Suppressed); err != nil {
+			return Plan{}, err
+		}
+		p.Done = pastEnd(sc, p.Next)
+	}
+
+	p.choose(sc, due, now)
+	if sc.MaxRuns != nil {
+		left := max(0, int64(*sc.MaxRuns)-sc.FireCount)
+		if int64(len(p.Fire)) >= left {
+			p.Fire, p.Done = p.Fire[:left], true
+		}
+	}
+	return p, nil
+}
+
+// choose sets the occurrences to fire from the due ones, applying the
+// misfire policy if any are late.
+func (p *Plan) choose(sc jobs.Schedule, due []time.Time, now time.Time) {
+	grace := time.Duration(sc.MisfireGraceSeconds) * time.Second
+	late := 0
+	for _, o := range due {
+		if now.Sub(o) > grace {
+			late++
+		}
+	}
+	if late == 0 {
+		p.Fire = due
+		return
+	}
+
+	fire := func(keep []time.Time) {
+		for _, o := range due[:len(due)-len(keep)] {
+			p.Missed.add(o)
+		}
+		p.Fire = keep
+	}
+	switch sc.MisfirePolicy {
+	case jobs.MisfireFireAll:
+		// the most recent ones, if there are more than the cap
+		n := sc.MaxCatchup
+		if n <= 0 {
+			n = jobs.DefaultMaxCatchup
+		}
+		fire(due[max(0, len(due)-n):])
+	case jobs.MisfireSkipToNext:
+		fire(due[late:]) // on-time occurrences are not misfires
+	default: // fire_once_now
+		fire(due[len(due)-1:])
+	}
+}
+
+// skipBehind moves a schedule that is more than maxScan occurrences behind
+// forward, so that walking to now stays bounded, and returns the
+// occurrences it passed over as missed.
+func skipBehind(sc jobs.Schedule, t, now time.Time) (time.Time, Missed, error) {
+	if !clockBased(sc) && sc.FixedIntervalSeconds != nil && *sc.FixedIntervalSeconds > 0 {
+		t, m := skipIntervals(sc, t, now)
+		return t, m, nil
+	}
+	return skipCron(sc, t, now)
+}
+
+// skipCron moves a cron that is more than maxScan occurrences behind forward
+// to an occurrence at most maxScan before now. The first maxScan it passes
+// over are walked and counted; the number of the rest is estimated from
+// their mean spacing, which is exact for crons firing at a fixed rate.
+// Calendars are not consulted for them.
+func skipCron(sc jobs.Schedule, t, now time.Time) (time.Time, Missed, error) {
+	last := now
+	if sc.EndAt != nil && sc.EndAt.Before(last) {
+		last = *sc.EndAt
+	}
+	m := Missed{First: t}
+	cur := t
+	for ; m.Count < maxScan; m.Count++ {
+		if cur.After(last) {
+			return t, Missed{}, nil
+		}
+		m.Last = cur
+		n, err := Next(sc, cur)
+		if err != nil {
+			return time.Time{}, Missed{}, err
+		}
+		cur = n
+	}
+	if cur.After(last) {
+		return t, Missed{}, nil
+	}
+
+	// Look for a start with between 1 and maxScan occurrences up to last:
+	// first maxScan/2 mean gaps back, then searching between too few and
+	// too many where the spacing near last differs.
+	gap := cur.Sub(t) / maxScan
+	span := last.Sub(cur)
+	lo, hi := time.Duration(0), time.Duration(-1) // hi < 0: none too many yet
+	d := min(max(gap*maxScan/2, time.Second), span)
+	for {
+		start, err := Next(sc, last.Add(-d-time.Nanosecond))
+		if err != nil {
+			return time.Time{}, Missed{}, err
+		}
+		n, err := countUpTo(sc, start, last, maxScan+1)
+		if err != nil {
+			return time.Time{}, Missed{}, err
+		}
+		switch {
+		case n > maxScan:
+			hi = d
+		case n == 0 && d < span:
+			lo = d
+		default:
+			if start.After(cur) {
+				m.Count += max(1, int(start.Sub(cur)/max(gap, 1)))
+				m.Last = start.Add(-gap)
+			}
+			return start, m, nil
+		}
+		if hi < 0 {
+			d = min(2*d, span)
+		} else {
+			d = lo + (hi-lo)/2
+		}
+	}
+}
+
+// countUpTo counts the occurrences from t to last, stopping at limit.
+func countUpTo(sc jobs.Schedule, t, last time.Time, limit int) (int, error) {
+	n := 0
+	for ; n < limit && !t.After(last); n++ {
+		next, err := Next(sc, t)
+		if err != nil {
+			return 0, err
+		}
+		t = next
+	}
+	return n, nil
+}
+
+// skipIntervals moves an elapsed interval that is more than maxScan
+// occurrences behind forward to the last maxScan of them, and returns the
+// ones it passed over as missed. Their number is worked out rather than
+// walked, and calendars are not consulted for them.
+func skipIntervals(sc jobs.Schedule, t, now time.Time) (time.Time, Missed) {
+	if clockBased(sc) || sc.FixedIntervalSeconds == nil || *sc.FixedIntervalSeconds <= 0 {
+		return t, Missed{}
+	}
+	last := now
+	if sc.EndAt != nil && sc.EndAt.Before(last) {
+		last = *sc.EndAt
+	}
+	if last.Before(t) {
+		return t, Missed{}
+	}
+	every := time.Duration(*sc.FixedIntervalSeconds) * time.Second
+	n := int64(last.Sub(t)/every) + 1 - maxScan
+	if n <= 0 {
+		return t, Missed{}
+	}
+	skip := time.Duration(n) * every
+	return t.Add(skip), Missed{Count: int(n), First: t, Last: t.Add(skip - every)}
+}
+
+func pastEnd(sc jobs.Schedule, t time.Time) bool {
+	return sc.EndAt != nil && t.After(*sc.EndAt)
+}
+
+// firstFrom returns the first occurrence at or after hold of the series
+// that t belongs to.
+func firstFrom(sc jobs.Schedule, t, hold time.Time) (time.Time, error) {
+	if !clockBased(sc) && sc.FixedIntervalSeconds != nil && *sc.FixedIntervalSeconds > 0 {
+		// elapsed intervals keep their phase
+		every := time.Duration(*sc.FixedIntervalSeconds) * time.Second
+		k := (hold.Sub(t) + every - 1) / every
+		return t.Add(k * every), nil
+	}
+	return Next(sc, hold.Add(-time.Nanosecond))
+}
+
+// FirstRun is a new schedule's first occurrence: after now, not before its
+// start_at or paused_until, and allowed by the calendars.
+func FirstRun(sc jobs.Schedule, cals *Calendars, now time.Time) (time.Time, error) {
+	t, err := Next(sc, now)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if hold := sc.HoldUntil(); t.Before(hold) {
+		if t, err = firstFrom(sc, t, hold); err != nil {
+			return time.Time{}, err
+		}
+	}
+	t, _, err = skipExcluded(sc, cals, t, nil)
+	return t, err
+}
@@ -0,0 +1,214 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rishansujesh/job-scheduler/internal/jobs"
+)
+
+// everyMinute was due at 10:00 and the scheduler comes back at 11:00:30.
+func everyMinute(policy jobs.MisfirePolicy) (jobs.Schedule, time.Time) {
+	cron := "* * * * *"
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	return jobs.Schedule{
+		CronExpr: &cron, NextRunAt: start, Timezone: "UTC",
+		MisfirePolicy: policy, MisfireGraceSeconds: 60, MaxCatchup: 5,
+	}, start.Add(time.Hour + 30*time.Second)
+}
+
+func TestPlanDue_OnTime(t *testing.T) {
+	sc, _ := everyMinute(jobs.MisfireSkipToNext)
+	now := sc.NextRunAt.Add(2 * time.Second)
+	p, err := PlanDue(sc, nil, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Fire) != 1 || !p.Fire[0].Equal(sc.NextRunAt) || p.Missed.Count != 0 {
+		t.Fatalf("got %+v", p)
+	}
+	if want := sc.NextRunAt.Add(time.Minute); !p.Next.Equal(want) {
+		t.Fatalf("next = %v, want %v", p.Next, want)
+	}
+}
+
+func TestPlanDue_Policies(t *testing.T) {
+	next := time.Date(2025, 1, 1, 11, 1, 0, 0, time.UTC)
+	cases := []struct {
+		policy    jobs.MisfirePolicy
+		missed    int
+		lastFired time.Time
+	}{
+		// 61 occurrences due (10:00..11:00); 11:00 is within grace
+		{jobs.MisfireFireOnceNow, 60, time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC)},
+		{jobs.MisfireSkipToNext, 60, time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC)},
+		{jobs.MisfireFireAll, 56, time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC)},
+	}
+	for _, c := range cases {
+		sc, now := everyMinute(c.policy)
+		p, err := PlanDue(sc, nil, now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.Missed.Count != c.missed {
+			t.Errorf("%s: missed %d, want %d", c.policy, p.Missed.Count, c.missed)
+		}
+		if len(p.Fire)+p.Missed.Count != 61 {
+			t.Errorf("%s: fired %d + missed %d != 61", c.policy, len(p.Fire), p.Missed.Count)
+		}
+		if len(p.Fire) == 0 || !p.Fire[len(p.Fire)-1].Equal(c.lastFired) {
+			t.Errorf("%s: fired %v", c.policy, p.Fire)
+		}
+		if !p.Missed.First.Equal(sc.NextRunAt) {
+			t.Errorf("%s: first missed %v", c.policy, p.Missed.First)
+		}
+		if !p.Next.Equal(next) {
+			t.Errorf("%s: next = %v, want %v", c.policy, p.Next, next)
+		}
+	}
+}
+
+func TestPlanDue_SkipToNextFiresNothingLate(t *testing.T) {
+	sc, now := everyMinute(jobs.MisfireSkipToNext)
+	sc.MisfireGraceSeconds = 0
+	p, err := PlanDue(sc, nil, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Fire) != 0 || p.Missed.Count != 61 {
+		t.Fatalf("fired %d missed %d", len(p.Fire), p.Missed.Count)
+	}
+}
+
+func TestPlanDue_FarBehindCountsEveryMissAndFiresTheLatest(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	cron, every := "* * * * * *", 1
+	for name, sc := range map[string]jobs.Schedule{
+		"cron":     {CronExpr: &cron},
+		"interval": {FixedIntervalSeconds: &every, IntervalMode: jobs.IntervalElapsed},
+	} {
+		sc.NextRunAt, sc.Timezone, sc.MisfirePolicy = start, "UTC", jobs.MisfireFireOnceNow
+		now := start.Add(24*time.Hour + 500*time.Millisecond)
+		p, err := PlanDue(sc, nil, now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// 86401 occurrences from 00:00:00 to 24:00:00: the last fires
+		last := start.Add(24 * time.Hour)
+		if len(p.Fire) != 1 || !p.Fire[0].Equal(last) {
+			t.Errorf("%s: fired %v, want %v", name, p.Fire, last)
+		}
+		if p.Missed.Count != 86400 || !p.Missed.First.Equal(start) || !p.Missed.Last.Equal(last.Add(-time.Second)) {
+			t.Errorf("%s: missed %+v", name, p.Missed)
+		}
+		if want := last.Add(time.Second); !p.Next.Equal(want) {
+			t.Errorf("%s: next %v, want %v", name, p.Next, want)
+		}
+	}
+}
+
+func TestPlanDue_FarBehindCronWalkIsBounded(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := start.Add(7*24*time.Hour + 500*time.Millisecond)
+	for _, c := range []struct {
+		cron   string
+		last   time.Time
+		missed int // -1: spacing is irregular, the count is estimated
+	}{
+		{"* * * * * *", start.Add(7 * 24 * time.Hour), 7 * 24 * 3600},
+		// every second of office hours on weekdays only
+		{"* * 9-16 * * MON-FRI", time.Date(2025, 1, 7, 16, 59, 59, 0, time.UTC), -1},
+	} {
+		sc := jobs.Schedule{CronExpr: &c.cron, NextRunAt: start, Timezone: "UTC", MisfirePolicy: jobs.MisfireFireOnceNow}
+		began := time.Now()
+		p, err := PlanDue(sc, nil, now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// a full walk takes over a second; the bounded one a few
+		// maxScan walks
+		if took := time.Since(began); took > 300*time.Millisecond {
+			t.Errorf("%s: planning took %v", c.cron, took)
+		}
+		if len(p.Fire) != 1 || !p.Fire[0].Equal(c.last) {
+			t.Errorf("%s: fired %v, want %v", c.cron, p.Fire, c.last)
+		}
+		if c.missed >= 0 && p.Missed.Count != c.missed {
+			t.Errorf("%s: missed %d, want %d", c.cron, p.Missed.Count, c.missed)
+		}
+		if !p.Missed.First.Equal(start) || !p.Next.After(now) {
+			t.Errorf("%s: first missed %v, next %v", c.cron, p.Missed.First, p.Next)
+		}
+	}
+}
+
+func TestPlanDue_FarBehindFireAllFiresTheMostRecent(t *testing.T) {
+	every := 1
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	sc := jobs.Schedule{
+		FixedIntervalSeconds: &every, IntervalMode: jobs.IntervalElapsed, NextRunAt: start, Timezone: "UTC",
+		MisfirePolicy: jobs.MisfireFireAll, MaxCatchup: 3,
+	}
+	now := start.Add(365 * 24 * time.Hour)
+	p, err := PlanDue(sc, nil, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Fire) != 3 || !p.Fire[2].Equal(now) {
+		t.Fatalf("fired %v", p.Fire)
+	}
+	if total := 365*24*3600 + 1; p.Missed.Count+len(p.Fire) != total {
+		t.Fatalf("missed %d + fired %d, want %d occurrences", p.Missed.Count, len(p.Fire), total)
+	}
+}
+
+func TestPlanDue_Bounds(t *testing.T) {
+	at := func(h, m int) *time.Time {
+		v := time.Date(2025, 1, 1, h, m, 0, 0, time.UTC)
+		return &v
+	}
+	three, five := 3, 5
+	cases := []struct {
+		name  string
+		bound func(*jobs.Schedule)
+		fired int
+		next  time.Time
+		done  bool
+	}{
+		{"paused", func(sc *jobs.Schedule) { sc.PausedUntil = at(12, 0) }, 0, *at(12, 0), false},
+		{"pause over", func(sc *jobs.Schedule) { sc.PausedUntil = at(11, 0) }, 1, *at(11, 1), false},
+		{"not started", func(sc *jobs.Schedule) { sc.StartAt = at(11, 30) }, 0, *at(11, 30), false},
+		{"ended", func(sc *jobs.Schedule) { sc.EndAt = at(10, 30) }, 1, *at(10, 31), true},
+		{"ends later", func(sc *jobs.Schedule) { sc.EndAt = at(11, 1) }, 1, *at(11, 1), false},
+		{"runs used up", func(sc *jobs.Schedule) { sc.MaxRuns, sc.FireCount = &three, 3 }, 0, *at(11, 1), true},
+		{"last run", func(sc *jobs.Schedule) { sc.MaxRuns, sc.FireCount = &five, 4 }, 1, *at(11, 1), true},
+	}
+	for _, c := range cases {
+		sc, now := everyMinute(jobs.MisfireFireOnceNow)
+		c.bound(&sc)
+		p, err := PlanDue(sc, nil, now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(p.Fire) != c.fired || !p.Next.Equal(c.next) || p.Done != c.done {
+			t.Errorf("%s: fired %v, next %v, done %v", c.name, p.Fire, p.Next, p.Done)
+		}
+	}
+}
+
+func TestPlanDue_PauseKeepsIntervalPhase(t *testing.T) {
+	every := 600
+	start := time.Date(2025, 1, 1, 10, 5, 0, 0, time.UTC)
+	until := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	sc := jobs.Schedule{
+		FixedIntervalSeconds: &every, NextRunAt: start, Timezone: "UTC", IntervalMode: jobs.IntervalElapsed,
+		MisfirePolicy: jobs.MisfireFireOnceNow, PausedUntil: &until,
+	}
+	p, err := PlanDue(sc, nil, start.Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2025, 1, 1, 12, 5, 0, 0, time.UTC); len(p.Fire) != 0 || !p.Next.Equal(want) {
+		t.Fatalf("fired %v, next %v, want %v", p.Fire, p.Next, want)
+	}
+}
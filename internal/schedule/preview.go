@@ -0,0 +1,295 @@
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rishansujesh/job-scheduler/internal/jobs"
+)
+
+// highFrequency is the gap below which a preview warns.
+const highFrequency = time.Minute
+
+// Preview returns the schedule's next n fire times after from, the
+// occurrences the calendars suppress before the last of them, and warnings
+// about DST changes and very frequent schedules.
+func Preview(sc jobs.Schedule, cals *Calendars, from time.Time, n int) ([]time.Time, []Suppressed, []string, error) {
+	loc, err := location(sc.Timezone)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	// one extra, so a single fire time still gets a frequency check
+	times := make([]time.Time, 0, n+1)
+	var suppressed []Suppressed
+	t := from
+	for len(times) < n+1 {
+		if t, err = Next(sc, t); err != nil {
+			return nil, nil, nil, err
+		}
+		if len(times) < n {
+			t, suppressed, err = skipExcluded(sc, cals, t, suppressed)
+		} else {
+			t, _, err = skipExcluded(sc, cals, t, nil)
+		}
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		times = append(times, t.In(loc))
+	}
+
+	var warnings []string
+	minGap := times[1].Sub(times[0])
+	for i := 2; i < len(times); i++ {
+		minGap = min(minGap, times[i].Sub(times[i-1]))
+	}
+	if minGap < highFrequency {
+		warnings = append(warnings, fmt.Sprintf("fires as often as every %s (more than once a minute)", minGap))
+	}
+	times = times[:n]
+
+	elapsed := !clockBased(sc)
+	prev := from.In(loc)
+	for _, t := range times {
+		switch {
+		case elapsed:
+			if zoneOffset(t) != zoneOffset(prev) {
+				warnings = append(warnings, fmt.Sprintf("%s: the UTC offset changes before this fire time; elapsed intervals shift the local time (interval_mode wall_clock keeps it)",
+					t.Format(time.RFC3339)))
+			}
+		case len(zoneTimes(wallTime(t, loc), loc)) == 2:
+			warnings = append(warnings, fmt.Sprintf("%s: local time is repeated by a DST change; it runs once, at the first occurrence",
+				t.Format(time.RFC3339)))
+		case afterSpringForward(t):
+			warnings = append(warnings, fmt.Sprintf("%s: clocks have just gone forward; fire times in the skipped hour run at the shifted time",
+				t.Format(time.RFC3339)))
+		}
+		prev = t
+	}
+	return times, suppressed, warnings, nil
+}
+
+// clockBased reports whether the schedule follows the local clock rather
+// than adding elapsed time.
+func clockBased(sc jobs.Schedule) bool {
+	if sc.CronExpr != nil && *sc.CronExpr != "" {
+		return !strings.Contains(*sc.CronExpr, "@every")
+	}
+	return sc.IntervalMode == jobs.IntervalWallClock
+}
+
+func zoneOffset(t time.Time) int {
+	_, off := t.Zone()
+	return off
+}
+
+// afterSpringForward reports whether t lies within a skipped interval's
+// length after clocks went forward, where skipped times are moved to.
+func afterSpringForward(t time.Time) bool {
+	off := zoneOffset(t)
+	prev := zoneOffset(t.Add(-3 * time.Hour))
+	if prev >= off {
+		return false
+	}
+	return zoneOffset(t.Add(-time.Duration(off-prev)*time.Second)) == prev
+}
+
+// Describe returns a short English description of the schedule.
+func Describe(sc jobs.Schedule) string {
+	var d string
+	switch {
+	case sc.CronExpr != nil && *sc.CronExpr != "":
+		d = DescribeCron(*sc.CronExpr)
+	case sc.FixedIntervalSeconds != nil:
+		d = "every " + seconds(*sc.FixedIntervalSeconds)
+		if sc.IntervalMode == jobs.IntervalWallClock {
+			d += " of local time"
+		}
+	default:
+		return "no schedule"
+	}
+	if sc.Timezone != "" && sc.Timezone != "UTC" {
+		d += " (" + sc.Timezone + ")"
+	}
+	return d
+}
+
+var descriptors = map[string]string{
+	"@yearly":   "at 00:00 on January 1",
+	"@annually": "at 00:00 on January 1",
+	"@monthly":  "at 00:00 on day 1 of every month",
+	"@weekly":   "at 00:00 every Sunday",
+	"@daily":    "at 00:00 every day",
+	"@midnight": "at 00:00 every day",
+	"@hourly":   "at minute 0 of every hour",
+}
+
+// DescribeCron describes a cron expression accepted by ParseCron. It does not
+// validate; unusual fields are quoted as written.
+func DescribeCron(expr string) string {
+	fields := strings.Fields(expr)
+	var tz string
+	if len(fields) > 0 && strings.Contains(fields[0], "TZ=") {
+		_, tz, _ = strings.Cut(fields[0], "=")
+		fields = fields[1:]
+	}
+	suffix := ""
+	if tz != "" {
+		suffix = " (" + tz + ")"
+	}
+	if len(fields) > 0 && strings.HasPrefix(fields[0], "@") {
+		if d, ok := descriptors[fields[0]]; ok {
+			return d + suffix
+		}
+		if len(fields) == 2 && fields[0] == "@every" {
+			return "every " + fields[1] + suffix
+		}
+		return strings.Join(fields, " ") + suffix
+	}
+	sec := "0"
+	if len(fields) == 6 {
+		sec, fields = fields[0], fields[1:]
+	}
+	if len(fields) != 5 {
+		return expr
+	}
+	minute, hour, dom, month, dow := fields[0], fields[1], fields[2], fields[3], fields[4]
+
+	var parts []string
+	s, sErr := strconv.Atoi(sec)
+	m, mErr := strconv.Atoi(minute)
+	h, hErr := strconv.Atoi(hour)
+	if sErr == nil && mErr == nil && hErr == nil {
+		at := fmt.Sprintf("at %02d:%02d", h, m)
+		if s != 0 {
+			at += fmt.Sprintf(":%02d", s)
+		}
+		parts = append(parts, at)
+	} else {
+		var tp []string
+		for _, f := range []struct{ v, unit string }{{sec, "second"}, {minute, "minute"}, {hour, "hour"}} {
+			switch {
+			case f.v == "*" && f.unit == "second":
+				tp = append(tp, "every second")
+			case f.v == "*" || (f.unit == "second" && f.v == "0"):
+			case strings.HasPrefix(f.v, "*/"):
+				n, _ := strconv.Atoi(f.v[2:])
+				tp = append(tp, "every "+count(n, f.unit))
+			default:
+				tp = append(tp, f.unit+" "+f.v)
+			}
+		}
+		switch {
+		case len(tp) == 0:
+			tp = []string{"every minute"}
+		case !strings.HasPrefix(tp[0], "every"):
+			tp[0] = "at " + tp[0]
+		}
+		parts = append(parts, strings.Join(tp, ", "))
+	}
+
+	var days []string
+	if dom != "*" && dom != "?" {
+		days = append(days, describeItems(dom, domText))
+	}
+	if dow != "*" && dow != "?" {
+		days = append(days, describeItems(dow, dowText))
+	}
+	if len(days) > 0 {
+		parts = append(parts, "on "+strings.Join(days, " or "))
+	}
+	if month != "*" {
+		parts = append(parts, "in "+describeItems(month, monthText))
+	}
+	return strings.Join(parts, ", ") + suffix
+}
+
+func describeItems(field string, item func(string) string) string {
+	items := strings.Split(strings.ToUpper(field), ",")
+	for i, it := range items {
+		items[i] = item(it)
+	}
+	return strings.Join(items, ", ")
+}
+
+func domText(it string) string {
+	switch {
+	case it == "L":
+		return "the last day of the month"
+	case it == "LW":
+		return "the last weekday of the month"
+	case strings.HasSuffix(it, "W"):
+		return "the weekday nearest day " + strings.TrimSuffix(it, "W")
+	}
+	return "day " + it
+}
+
+var ordinals = []string{"", "first", "second", "third", "fourth", "fifth"}
+
+func dowText(it string) string {
+	if day, nth, ok := strings.Cut(it, "#"); ok {
+		if k, err := strconv.Atoi(nth); err == nil && k > 0 && k < len(ordinals) {
+			return "the " + ordinals[k] + " " + dayName(day) + " of the month"
+		}
+	}
+	if len(it) > 1 && strings.HasSuffix(it, "L") {
+		return "the last " + dayName(strings.TrimSuffix(it, "L")) + " of the month"
+	}
+	return rangeText(it, dayName)
+}
+
+func monthText(it string) string {
+	return rangeText(it, func(s string) string {
+		if n, err := strconv.Atoi(s); err == nil && n >= 1 && n <= 12 {
+			return time.Month(n).String()
+		}
+		for m := time.January; m <= time.December; m++ {
+			if strings.EqualFold(s, m.String()[:3]) {
+				return m.String()
+			}
+		}
+		return s
+	})
+}
+
+// rangeText names both ends of "a-b"; steps are left as written.
+func rangeText(it string, name func(string) string) string {
+	if strings.Contains(it, "/") {
+		return it
+	}
+	if a, b, ok := strings.Cut(it, "-"); ok {
+		return name(a) + " to " + name(b)
+	}
+	return name(it)
+}
+
+func dayName(s string) string {
+	if n, ok := dowNames[s]; ok {
+		return time.Weekday(n).String()
+	}
+	if n, err := strconv.Atoi(s); err == nil && n >= 0 && n <= 7 {
+		return time.Weekday(n % 7).String()
+	}
+	return s
+}
+
+func count(n int, unit string) string {
+	if n == 1 {
+		return unit
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
+
+// seconds formats n seconds in the largest whole unit.
+func seconds(n int) string {
+	for _, u := range []struct {
+		secs int
+		name string
+	}{{86400, "day"}, {3600, "hour"}, {60, "minute"}} {
+		if n >= u.secs && n%u.secs == 0 {
+			return count(n/u.secs, u.name)
+		}
+	}
+	return count(n, "second")
+}
@@ -0,0 +1,71 @@
+package schedule
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rishansujesh/job-scheduler/internal/jobs"
+)
+
+func TestDescribeCron(t *testing.T) {
+	cases := map[string]string{
+		"30 2 * * *":                    "at 02:30",
+		"*/5 * * * *":                   "every 5 minutes",
+		"*/15 * * * * *":                "every 15 seconds",
+		"* * * * *":                     "every minute",
+		"0 9-17 * * MON-FRI":            "at minute 0, hour 9-17, on Monday to Friday",
+		"0 9 L * *":                     "at 09:00, on the last day of the month",
+		"0 9 * * MON#2":                 "at 09:00, on the second Monday of the month",
+		"0 9 15W 1,7 *":                 "at 09:00, on the weekday nearest day 15, in January, July",
+		"0 0 1 * 5L":                    "at 00:00, on day 1 or the last Friday of the month",
+		"@hourly":                       "at minute 0 of every hour",
+		"@every 90s":                    "every 90s",
+		"TZ=Europe/Berlin 30 2 * * SUN": "at 02:30, on Sunday (Europe/Berlin)",
+	}
+	for expr, want := range cases {
+		if got := DescribeCron(expr); got != want {
+			t.Errorf("%s: got %q, want %q", expr, got, want)
+		}
+	}
+}
+
+func TestPreview(t *testing.T) {
+	cron := "30 1 * * *"
+	sc := jobs.Schedule{CronExpr: &cron, Timezone: "America/New_York"}
+	from := time.Date(2025, 11, 1, 12, 0, 0, 0, time.UTC)
+	times, _, warnings, err := Preview(sc, nil, from, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(times) != 3 || times[0].Format(time.RFC3339) != "2025-11-02T01:30:00-04:00" {
+		t.Fatalf("times %v", times)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "repeated") {
+		t.Fatalf("warnings %q", warnings)
+	}
+}
+
+func TestPreview_Warnings(t *testing.T) {
+	every := "*/10 * * * * *"
+	skipped := "30 2 * * *"
+	interval := 86400
+	cases := []struct {
+		sc   jobs.Schedule
+		want string
+	}{
+		{jobs.Schedule{CronExpr: &every}, "more than once a minute"},
+		{jobs.Schedule{CronExpr: &skipped, Timezone: "America/New_York"}, "clocks have just gone forward"},
+		{jobs.Schedule{FixedIntervalSeconds: &interval, Timezone: "America/New_York"}, "elapsed intervals shift"},
+	}
+	from := time.Date(2025, 3, 8, 12, 0, 0, 0, time.UTC)
+	for _, c := range cases {
+		_, _, warnings, err := Preview(c.sc, nil, from, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(warnings) == 0 || !strings.Contains(strings.Join(warnings, "\n"), c.want) {
+			t.Errorf("%s: warnings %q", Describe(c.sc), warnings)
+		}
+	}
+}
@@ -1,4 +1,4 @@
 package schedule
 
 // Intentionally empty.
-// The scheduling scan loop is implemented in services/scheduler/main.go.
+// The scheduling scan loop is implemented in services/scheduler/scan.go.
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrorClass tells the runner what to do with a failed execution.
+type ErrorClass string
+
+const (
+	// ClassTransient failures are retried with backoff until attempts run out.
+	ClassTransient ErrorClass = "transient"
+	// ClassPermanent failures will not succeed on retry (bad args, 4xx, ...)
+	// and go straight to failed/DLQ.
+	ClassPermanent ErrorClass = "permanent"
+	// ClassRateLimited failures are retried no earlier than RetryAfter.
+	ClassRateLimited ErrorClass = "rate_limited"
+)
+
+// Error is a classified handler error.
+type Error struct {
+	Class      ErrorClass
+	RetryAfter time.Duration // only meaningful for ClassRateLimited
+	Err        error
+}
+
+func (e *Error) Error() string { return e.Err.Error() }
+func (e *Error) Unwrap() error { return e.Err }
+
+func Permanent(err error) error { return &Error{Class: ClassPermanent, Err: err} }
+func Transient(err error) error { return &Error{Class: ClassTransient, Err: err} }
+func RateLimited(err error, retryAfter time.Duration) error {
+	return &Error{Class: ClassRateLimited, RetryAfter: retryAfter, Err: err}
+}
+
+// Classify returns the class of err. Unclassified errors are treated as
+// transient so that handlers which predate classification keep retrying.
+func Classify(err error) ErrorClass {
+	var he *Error
+	if errors.As(err, &he) {
+		return he.Class
+	}
+	return ClassTransient
+}
+
+// RetryAfter returns the server-requested delay of a rate-limited error, or 0.
+func RetryAfter(err error) time.Duration {
+	var he *Error
+	if errors.As(err, &he) && he.Class == ClassRateLimited {
+		return he.RetryAfter
+	}
+	return 0
+}
@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Request is one execution of a job's handler.
+type Request struct {
+	RunID   string
+	JobID   string
+	Attempt int // 1-based
+	Args    json.RawMessage
+}
+
+// Handler executes one kind of job. Validate is called by the API when a job
+// is created or its args change; Execute by the worker for every attempt.
+// Execute should return classified errors (Permanent, Transient, RateLimited).
+type Handler interface {
+	Validate(args json.RawMessage) error
+	Execute(ctx context.Context, req Request) (Result, error)
+}
+
+var ErrUnknownHandler = errors.New("unknown handler")
+
+// Registry maps handler names (jobs.handler) to implementations.
+type Registry struct {
+	mu sync.RWMutex
+	m  map[string]Handler
+}
+
+func NewRegistry() *Registry {
+	return &Registry{m: map[string]Handler{}}
+}
+
+// Builtin returns a registry with the handlers compiled into the worker.
+func Builtin() *Registry {
+	r := NewRegistry()
+	_ = r.Register("shell", Shell{})
+	_ = r.Register("http", HTTP{})
+	return r
+}
+
+func (r *Registry) Register(name string, h Handler) error {
+	if name == "" {
+		return fmt.Errorf("handler name required")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, dup := r.m[name]; dup {
+		return fmt.Errorf("handler %q already registered", name)
+	}
+	r.m[name] = h
+	return nil
+}
+
+func (r *Registry) Get(name string) (Handler, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	h, ok := r.m[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownHandler, name)
+	}
+	return h, nil
+}
+
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	out := make([]string, 0, len(r.m))
+	for n := range r.m {
+		out = append(out, n)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Validate checks args against the named handler.
+func (r *Registry) Validate(name string, args json.RawMessage) error {
+	h, err := r.Get(name)
+	if err != nil {
+		return err
+	}
+	return h.Validate(args)
+}
+
+// Shell runs ShellArgs.
+type Shell struct{}
+
+func (Shell) Validate(args json.RawMessage) error {
+	var a ShellArgs
+	if err := decodeArgs(args, &a); err != nil {
+		return err
+	}
+	if a.Command == "" {
+		return fmt.Errorf("shell: command required")
+	}
+	return nil
+}
+
+func (Shell) Execute(ctx context.Context, req Request) (Result, error) {
+	var a ShellArgs
+	if err := decodeArgs(req.Args, &a); err != nil {
+		return Result{}, Permanent(err)
+	}
+	return RunShell(ctx, a)
+}
+
+// HTTP runs HTTPArgs.
+type HTTP struct{}
+
+func (HTTP) Validate(args json.RawMessage) error {
+	var a HTTPArgs
+	if err := decodeArgs(args, &a); err != nil {
+		return err
+	}
+	if a.URL == "" {
+		return fmt.Errorf("http: url required")
+	}
+	return nil
+}
+
+func (HTTP) Execute(ctx context.Context, req Request) (Result, error) {
+	var a HTTPArgs
+	if err := decodeArgs(req.Args, &a); err != nil {
+		return Result{}, Permanent(err)
+	}
+	return RunHTTP(ctx, a)
+}
+
+func decodeArgs(args json.RawMessage, v any) error {
+	if len(args) == 0 || string(args) == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(args, v); err != nil {
+		return fmt.Errorf("args: %w", err)
+	}
+	return nil
+}
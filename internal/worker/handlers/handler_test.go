@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestRegistry(t *testing.T) {
+	r := Builtin()
+	if got := r.Names(); len(got) != 2 || got[0] != "http" || got[1] != "shell" {
+		t.Fatalf("builtin names = %v", got)
+	}
+	if err := r.Register("shell", Shell{}); err == nil {
+		t.Fatalf("duplicate register should fail")
+	}
+	if err := r.Validate("nope", nil); !errors.Is(err, ErrUnknownHandler) {
+		t.Fatalf("want ErrUnknownHandler, got %v", err)
+	}
+	if err := r.Validate("shell", json.RawMessage(`{}`)); err == nil {
+		t.Fatalf("shell without command should be invalid")
+	}
+	if err := r.Validate("http", json.RawMessage(`{"url":"http://x"}`)); err != nil {
+		t.Fatalf("valid http args rejected: %v", err)
+	}
+	if err := r.Validate("shell", json.RawMessage(`{"command":1}`)); err == nil {
+		t.Fatalf("mistyped args should be invalid")
+	}
+}
+
+func TestShellHandler_Execute(t *testing.T) {
+	h, _ := Builtin().Get("shell")
+	res, err := h.Execute(context.Background(), Request{RunID: "r", Attempt: 1, Args: json.RawMessage(`{"command":"echo hi"}`)})
+	if err != nil || res.Stdout != "hi\n" {
+		t.Fatalf("res=%+v err=%v", res, err)
+	}
+}
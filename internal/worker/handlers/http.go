@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"time"
 )
 
@@ -24,7 +25,7 @@ func RunHTTP(ctx context.Context, a HTTPArgs) (Result, error) {
 		a.Method = "GET"
 	}
 	if a.URL == "" {
-		return Result{}, fmt.Errorf("http: url required")
+		return Result{}, Permanent(fmt.Errorf("http: url required"))
 	}
 	to := time.Duration(a.TimeoutMS) * time.Millisecond
 	if to <= 0 {
@@ -37,14 +38,14 @@ func RunHTTP(ctx context.Context, a HTTPArgs) (Result, error) {
 	if a.Body != nil {
 		b, err := json.Marshal(a.Body)
 		if err != nil {
-			return Result{}, fmt.Errorf("http: body marshal: %w", err)
+			return Result{}, Permanent(fmt.Errorf("http: body marshal: %w", err))
 		}
 		bodyReader = bytes.NewReader(b)
 	}
 
 	req, err := http.NewRequestWithContext(cctx, a.Method, a.URL, bodyReader)
 	if err != nil {
-		return Result{}, fmt.Errorf("http: new request: %w", err)
+		return Result{}, Permanent(fmt.Errorf("http: new request: %w", err))
 	}
 	for k, v := range a.Headers {
 		req.Header.Set(k, v)
@@ -56,25 +57,55 @@ func RunHTTP(ctx context.Context, a HTTPArgs) (Result, error) {
 	client := &http.Client{Timeout: to}
 	resp, err := client.Do(req)
 	if err != nil {
-		return Result{Retryable: true}, fmt.Errorf("http: %w", err)
+		// network errors and timeouts
+		return Result{}, Transient(fmt.Errorf("http: %w", err))
 	}
 	defer resp.Body.Close()
 
 	respBody, _ := io.ReadAll(resp.Body)
 	res := Result{
-		Stdout:    string(respBody),
-		Retryable: false,
+		Stdout:     string(respBody),
+		HTTPStatus: resp.StatusCode,
 	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return res, nil
+	}
+	return res, classifyStatus(resp, a.RetryOnCodes)
+}
 
-	// Retry on configured status codes
-	for _, c := range a.RetryOnCodes {
-		if resp.StatusCode == c {
-			res.Retryable = true
-			break
+// classifyStatus maps a non-2xx response to an error class. 429 is always
+// rate-limited (honouring Retry-After). If retry_on_codes is set it decides
+// which other codes are transient; otherwise 408 and 5xx are transient and
+// remaining 4xx are permanent.
+func classifyStatus(resp *http.Response, retryOn []int) error {
+	err := fmt.Errorf("http: status %d", resp.StatusCode)
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return RateLimited(err, parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()))
+	}
+	if len(retryOn) > 0 {
+		for _, c := range retryOn {
+			if resp.StatusCode == c {
+				return Transient(err)
+			}
 		}
+		return Permanent(err)
 	}
-	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
-		return res, nil
+	if resp.StatusCode == http.StatusRequestTimeout || resp.StatusCode >= 500 {
+		return Transient(err)
+	}
+	return Permanent(err)
+}
+
+// parseRetryAfter accepts delay-seconds or an HTTP-date.
+func parseRetryAfter(v string, now time.Time) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs > 0 {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil && t.After(now) {
+		return t.Sub(now)
 	}
-	return res, fmt.Errorf("http: status %d", resp.StatusCode)
+	return 0
 }
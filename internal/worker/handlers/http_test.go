@@ -2,7 +2,11 @@ package handlers
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
 	"testing"
+	"time"
 )
 
 func TestRunHTTP_MissingURL(t *testing.T) {
@@ -10,4 +14,57 @@ func TestRunHTTP_MissingURL(t *testing.T) {
 	if err == nil {
 		t.Fatalf("expected error for missing URL")
 	}
+	if Classify(err) != ClassPermanent {
+		t.Fatalf("missing URL should be permanent")
+	}
+}
+
+func TestRunHTTP_ClassifiesStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		code, _ := strconv.Atoi(r.URL.Query().Get("code"))
+		if code == http.StatusTooManyRequests {
+			w.Header().Set("Retry-After", "7")
+		}
+		w.WriteHeader(code)
+	}))
+	defer srv.Close()
+
+	cases := []struct {
+		code    int
+		retryOn []int
+		want    ErrorClass
+	}{
+		{400, nil, ClassPermanent},
+		{404, nil, ClassPermanent},
+		{408, nil, ClassTransient},
+		{503, nil, ClassTransient},
+		{429, nil, ClassRateLimited},
+		{409, []int{409}, ClassTransient},
+		{503, []int{409}, ClassPermanent},
+	}
+	for _, c := range cases {
+		res, err := RunHTTP(context.Background(), HTTPArgs{
+			URL:          srv.URL + "?code=" + strconv.Itoa(c.code),
+			RetryOnCodes: c.retryOn,
+		})
+		if err == nil {
+			t.Fatalf("code %d: expected error", c.code)
+		}
+		if got := Classify(err); got != c.want {
+			t.Errorf("code %d retry_on=%v: want %s, got %s", c.code, c.retryOn, c.want, got)
+		}
+		if res.HTTPStatus != c.code {
+			t.Errorf("code %d: HTTPStatus=%d", c.code, res.HTTPStatus)
+		}
+		if c.code == 429 && RetryAfter(err) != 7*time.Second {
+			t.Errorf("want Retry-After 7s, got %v", RetryAfter(err))
+		}
+	}
+}
+
+func TestRunHTTP_NetworkErrorIsTransient(t *testing.T) {
+	_, err := RunHTTP(context.Background(), HTTPArgs{URL: "http://127.0.0.1:1", TimeoutMS: 500})
+	if Classify(err) != ClassTransient {
+		t.Fatalf("want transient, got %v (%v)", Classify(err), err)
+	}
 }
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"os/exec"
@@ -10,17 +11,21 @@ import (
 type ShellArgs struct {
 	Command    string `json:"command"`
 	TimeoutSec int    `json:"timeout_sec,omitempty"`
+	// PermanentExitCodes lets a script report failures that retrying
+	// cannot fix (e.g. input validation). 126 and 127 are always permanent.
+	PermanentExitCodes []int `json:"permanent_exit_codes,omitempty"`
 }
 
 type Result struct {
-	Stdout    string
-	Stderr    string
-	Retryable bool
+	Stdout     string
+	Stderr     string
+	ExitCode   *int // shell only; nil if the process never started
+	HTTPStatus int  // http only; 0 if no response was received
 }
 
 func RunShell(ctx context.Context, a ShellArgs) (Result, error) {
 	if a.Command == "" {
-		return Result{}, fmt.Errorf("shell: command required")
+		return Result{}, Permanent(fmt.Errorf("shell: command required"))
 	}
 	to := time.Duration(a.TimeoutSec) * time.Second
 	if to <= 0 {
@@ -29,16 +34,50 @@ func RunShell(ctx context.Context, a ShellArgs) (Result, error) {
 	cctx, cancel := context.WithTimeout(ctx, to)
 	defer cancel()
 
+	var stdout, stderr bytes.Buffer
 	cmd := exec.CommandContext(cctx, "/bin/sh", "-c", a.Command)
-	out, err := cmd.CombinedOutput()
-	res := Result{Stdout: string(out), Stderr: "", Retryable: false}
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	// don't wait forever on pipes held open by orphaned grandchildren
+	cmd.WaitDelay = 5 * time.Second
+	err := cmd.Run()
+	res := Result{Stdout: stdout.String(), Stderr: stderr.String()}
+	if cmd.ProcessState != nil {
+		code := cmd.ProcessState.ExitCode()
+		res.ExitCode = &code
+	}
 
 	if cctx.Err() == context.DeadlineExceeded {
-		return res, fmt.Errorf("shell: timeout after %v", to)
+		return res, Transient(fmt.Errorf("shell: timeout after %v", to))
 	}
 	if err != nil {
-		// Consider non-zero exit codes as non-retryable by default.
-		return res, fmt.Errorf("shell: %v; output=%q", err, string(out))
+		err = fmt.Errorf("shell: %v; stderr=%q", err, tail(res.Stderr, 512))
+		if res.ExitCode != nil && isPermanentExit(*res.ExitCode, a.PermanentExitCodes) {
+			return res, Permanent(err)
+		}
+		// Other non-zero exits (and signals) may be flaky; retry them.
+		return res, Transient(err)
 	}
 	return res, nil
 }
+
+func isPermanentExit(code int, extra []int) bool {
+	// 126: not executable, 127: command not found
+	if code == 126 || code == 127 {
+		return true
+	}
+	for _, c := range extra {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// tail returns at most the last n bytes of s, for error messages.
+func tail(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[len(s)-n:]
+}
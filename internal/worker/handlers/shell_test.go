@@ -11,3 +11,34 @@ func TestRunShell_MissingCommand(t *testing.T) {
 		t.Fatalf("expected error for missing command")
 	}
 }
+
+func TestRunShell_SeparatesStdoutAndStderr(t *testing.T) {
+	res, err := RunShell(context.Background(), ShellArgs{Command: "echo out; echo err 1>&2; exit 3"})
+	if err == nil {
+		t.Fatalf("expected error for non-zero exit")
+	}
+	if res.Stdout != "out\n" || res.Stderr != "err\n" {
+		t.Fatalf("stdout=%q stderr=%q", res.Stdout, res.Stderr)
+	}
+	if res.ExitCode == nil || *res.ExitCode != 3 {
+		t.Fatalf("want exit code 3, got %v", res.ExitCode)
+	}
+}
+
+func TestRunShell_ExitCodeClassification(t *testing.T) {
+	cases := []struct {
+		args ShellArgs
+		want ErrorClass
+	}{
+		{ShellArgs{Command: "exit 1"}, ClassTransient},
+		{ShellArgs{Command: "no-such-command-xyz"}, ClassPermanent},
+		{ShellArgs{Command: "exit 2", PermanentExitCodes: []int{2}}, ClassPermanent},
+		{ShellArgs{}, ClassPermanent},
+	}
+	for _, c := range cases {
+		_, err := RunShell(context.Background(), c.args)
+		if got := Classify(err); got != c.want {
+			t.Errorf("%q: want %s, got %s (%v)", c.args.Command, c.want, got, err)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/rishansujesh/job-scheduler/internal/jobs"
+)
+
+// jobLimits are the limits of a job the worker enforces on its runs.
+type jobLimits struct {
+	maxConcurrency int             // 0 = unlimited
+	rateLimit      *jobs.RateLimit // nil = unlimited
+	loadedAt       time.Time
+}
+
+// limitCache holds the job and host limits last read from the database.
+type limitCache struct {
+	mu          sync.Mutex
+	jobs        map[string]jobLimits
+	hosts       map[string]jobs.RateLimit
+	hostsLoaded time.Time
+}
+
+func (r *Runner) limitTTL() time.Duration {
+	if r.LimitTTL > 0 {
+		return r.LimitTTL
+	}
+	return 10 * time.Second
+}
+
+// limitsFor returns the job's max_concurrency and rate limit from its row,
+// read at most every LimitTTL, so that changing them also affects runs
+// already queued. If the row cannot be read, the limits the run was
+// enqueued with apply.
+func (r *Runner) limitsFor(ctx context.Context, jobID string, payload map[string]any) jobLimits {
+	c := &r.limits
+	c.mu.Lock()
+	l, ok := c.jobs[jobID]
+	c.mu.Unlock()
+	if ok && time.Since(l.loadedAt) < r.limitTTL() {
+		return l
+	}
+
+	j, err := r.Store.GetJob(ctx, jobID)
+	if err != nil {
+		if ok {
+			return l
+		}
+		return payloadLimits(payload)
+	}
+	l = jobLimits{maxConcurrency: j.MaxConcurrency, rateLimit: j.RateLimit, loadedAt: time.Now()}
+	c.mu.Lock()
+	if c.jobs == nil {
+		c.jobs = map[string]jobLimits{}
+	}
+	c.jobs[jobID] = l
+	c.mu.Unlock()
+	return l
+}
+
+// payloadLimits are the limits a run message carries from when it was
+// enqueued.
+func payloadLimits(payload map[string]any) jobLimits {
+	var l jobLimits
+	l.maxConcurrency, _ = toInt(payload["max_concurrency"])
+	if raw, ok := payload["rate_limit"]; ok && raw != nil {
+		var rl jobs.RateLimit
+		b, _ := json.Marshal(raw)
+		if err := json.Unmarshal(b, &rl); err == nil && rl.Validate() == nil {
+			l.rateLimit = &rl
+		}
+	}
+	return l
+}
+
+// hostLimit returns host's rate limit, reloading the host limits when they
+// are older than LimitTTL. If a reload fails the limits loaded before stay
+// in use.
+func (r *Runner) hostLimit(ctx context.Context, host string) (jobs.RateLimit, bool) {
+	c := &r.limits
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.hosts == nil || time.Since(c.hostsLoaded) >= r.limitTTL() {
+		c.hostsLoaded = time.Now()
+		if hs, err := r.Store.ListHostRateLimits(ctx); err != nil {
+			r.Logger.Printf("load host rate limits: %v", err)
+		} else {
+			c.hosts = make(map[string]jobs.RateLimit, len(hs))
+			for _, h := range hs {
+				c.hosts[h.Host] = h.RateLimit
+			}
+		}
+	}
+	l, ok := c.hosts[host]
+	return l, ok
+}
@@ -0,0 +1,260 @@
+// Package plugin runs job handlers out of process. A plugin is any binary
+// that serves the Handler gRPC service (see proto/plugin.proto) on the Unix
+// socket named by $JOB_PLUGIN_SOCKET; Go plugins can simply call Serve.
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/rishansujesh/job-scheduler/internal/worker/handlers"
+	pb "github.com/rishansujesh/job-scheduler/internal/worker/plugin/proto"
+)
+
+// SocketEnv names the environment variable carrying the socket path.
+const SocketEnv = "JOB_PLUGIN_SOCKET"
+
+// Process is a handlers.Handler backed by a plugin subprocess. The process is
+// started on first use and restarted on the next call if it has exited.
+type Process struct {
+	Name         string
+	Path         string
+	Args         []string
+	Logger       *log.Logger
+	StartTimeout time.Duration
+	CallTimeout  time.Duration // Validate only; Execute uses the caller's ctx
+
+	mu     sync.Mutex
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser // closing it tells the plugin to exit
+	exited chan struct{}
+	conn   *grpc.ClientConn
+	client pb.HandlerClient
+	dir    string
+}
+
+func New(name, path string, logger *log.Logger) *Process {
+	return &Process{
+		Name:         name,
+		Path:         path,
+		Logger:       logger,
+		StartTimeout: 10 * time.Second,
+		CallTimeout:  5 * time.Second,
+	}
+}
+
+// Load registers the plugins in spec ("name=/path/to/bin,other=/path") with
+// reg. Processes are not started until first used.
+func Load(reg *handlers.Registry, spec string, logger *log.Logger) ([]*Process, error) {
+	var out []*Process
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		name, path, ok := strings.Cut(item, "=")
+		if !ok || name == "" || path == "" {
+			return nil, fmt.Errorf("plugin spec %q: want name=path", item)
+		}
+		p := New(name, path, logger)
+		if err := reg.Register(name, p); err != nil {
+			return nil, err
+		}
+		out = append(out, p)
+	}
+	return out, nil
+}
+
+func (p *Process) Validate(args json.RawMessage) error {
+	ctx, cancel := context.WithTimeout(context.Background(), p.CallTimeout)
+	defer cancel()
+	c, err := p.ensure(ctx)
+	if err != nil {
+		return fmt.Errorf("plugin %s: %w", p.Name, err)
+	}
+	resp, err := c.Validate(ctx, &pb.ValidateRequest{ArgsJson: string(args)})
+	if err != nil {
+		return fmt.Errorf("plugin %s: validate: %w", p.Name, err)
+	}
+	if resp.GetError() != "" {
+		return errors.New(resp.GetError())
+	}
+	return nil
+}
+
+func (p *Process) Execute(ctx context.Context, req handlers.Request) (handlers.Result, error) {
+	c, err := p.ensure(ctx)
+	if err != nil {
+		return handlers.Result{}, handlers.Transient(fmt.Errorf("plugin %s: %w", p.Name, err))
+	}
+	resp, err := c.Execute(ctx, &pb.ExecuteRequest{
+		RunId:    req.RunID,
+		JobId:    req.JobID,
+		Attempt:  int32(req.Attempt),
+		ArgsJson: string(req.Args),
+	})
+	if err != nil {
+		// transport failure or plugin crash: the next call restarts it
+		return handlers.Result{}, handlers.Transient(fmt.Errorf("plugin %s: execute: %w", p.Name, err))
+	}
+
+	res := handlers.Result{Stdout: resp.GetStdout(), Stderr: resp.GetStderr()}
+	if resp.ExitCode != nil {
+		code := int(resp.GetExitCode())
+		res.ExitCode = &code
+	}
+	if resp.GetError() == "" {
+		return res, nil
+	}
+	execErr := fmt.Errorf("plugin %s: %s", p.Name, resp.GetError())
+	switch handlers.ErrorClass(resp.GetErrorClass()) {
+	case handlers.ClassPermanent:
+		return res, handlers.Permanent(execErr)
+	case handlers.ClassRateLimited:
+		return res, handlers.RateLimited(execErr, time.Duration(resp.GetRetryAfterMs())*time.Millisecond)
+	default:
+		return res, handlers.Transient(execErr)
+	}
+}
+
+// ensure returns a client for a running plugin, starting it if needed.
+func (p *Process) ensure(ctx context.Context) (pb.HandlerClient, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.client != nil {
+		select {
+		case <-p.exited:
+			p.Logger.Printf("plugin %s exited; restarting", p.Name)
+			p.stopLocked()
+		default:
+			return p.client, nil
+		}
+	}
+	if err := p.startLocked(ctx); err != nil {
+		p.stopLocked()
+		return nil, err
+	}
+	return p.client, nil
+}
+
+func (p *Process) startLocked(ctx context.Context) error {
+	dir, err := os.MkdirTemp("", "jobplugin-")
+	if err != nil {
+		return err
+	}
+	p.dir = dir
+	sock := filepath.Join(dir, "plugin.sock")
+
+	cmd := exec.Command(p.Path, p.Args...)
+	cmd.Env = append(os.Environ(), SocketEnv+"="+sock)
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("start %s: %w", p.Path, err)
+	}
+	p.cmd, p.stdin = cmd, stdin
+	exited := make(chan struct{})
+	p.exited = exited
+	go func() {
+		_ = cmd.Wait()
+		close(exited)
+	}()
+
+	// Wait for the socket before dialing; gRPC would otherwise back off for
+	// a second after the first failed connect.
+	deadline := time.Now().Add(p.StartTimeout)
+	for {
+		if _, err := os.Stat(sock); err == nil {
+			break
+		}
+		select {
+		case <-exited:
+			return fmt.Errorf("plugin %s exited during startup", p.Path)
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(20 * time.Millisecond):
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("plugin %s did not create its socket within %v", p.Path, p.StartTimeout)
+		}
+	}
+
+	conn, err := grpc.Dial("unix://"+sock, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	p.conn = conn
+	p.client = pb.NewHandlerClient(conn)
+
+	// Wait for the plugin to serve and check it is what we expect.
+	for {
+		cctx, cancel := context.WithTimeout(ctx, 200*time.Millisecond)
+		resp, err := p.client.Describe(cctx, &pb.DescribeRequest{})
+		cancel()
+		if err == nil {
+			if resp.GetName() != p.Name {
+				return fmt.Errorf("plugin at %s reports name %q, want %q", p.Path, resp.GetName(), p.Name)
+			}
+			return nil
+		}
+		select {
+		case <-exited:
+			return fmt.Errorf("plugin %s exited during startup", p.Path)
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("plugin %s not ready after %v: %w", p.Path, p.StartTimeout, err)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+// stopLocked tears down the connection and process, if any.
+func (p *Process) stopLocked() {
+	if p.conn != nil {
+		_ = p.conn.Close()
+	}
+	if p.stdin != nil {
+		_ = p.stdin.Close()
+	}
+	if p.cmd != nil && p.exited != nil {
+		select {
+		case <-p.exited:
+		case <-time.After(2 * time.Second):
+			_ = p.cmd.Process.Kill()
+			<-p.exited
+		}
+	}
+	if p.dir != "" {
+		_ = os.RemoveAll(p.dir)
+	}
+	p.cmd, p.stdin, p.exited, p.conn, p.client, p.dir = nil, nil, nil, nil, nil, ""
+}
+
+// Close stops the plugin process.
+func (p *Process) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.stopLocked()
+	return nil
+}
@@ -0,0 +1,147 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/rishansujesh/job-scheduler/internal/worker/handlers"
+)
+
+// When started as a plugin by the tests below, the test binary serves
+// echoHandler instead of running tests.
+func TestMain(m *testing.M) {
+	if os.Getenv(SocketEnv) != "" {
+		if err := Serve(os.Getenv("TEST_PLUGIN_NAME"), echoHandler{}); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+type echoHandler struct{}
+
+type echoArgs struct {
+	Say  string `json:"say"`
+	Fail string `json:"fail"` // "", "permanent", "rate_limited", "crash"
+}
+
+func (echoHandler) Validate(args json.RawMessage) error {
+	var a echoArgs
+	if err := json.Unmarshal(args, &a); err != nil {
+		return err
+	}
+	if a.Say == "" {
+		return errors.New("say required")
+	}
+	return nil
+}
+
+func (echoHandler) Execute(_ context.Context, req handlers.Request) (handlers.Result, error) {
+	var a echoArgs
+	_ = json.Unmarshal(req.Args, &a)
+	res := handlers.Result{Stdout: fmt.Sprintf("%s run=%s attempt=%d", a.Say, req.RunID, req.Attempt)}
+	switch a.Fail {
+	case "permanent":
+		return res, handlers.Permanent(errors.New("bad input"))
+	case "rate_limited":
+		return res, handlers.RateLimited(errors.New("slow down"), 3*time.Second)
+	case "crash":
+		os.Exit(3)
+	}
+	return res, nil
+}
+
+func newTestProcess(t *testing.T, name string) *Process {
+	t.Helper()
+	exe, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("TEST_PLUGIN_NAME", "echo")
+	p := New(name, exe, log.New(io.Discard, "", 0))
+	t.Cleanup(func() { _ = p.Close() })
+	return p
+}
+
+func TestProcess_ValidateAndExecute(t *testing.T) {
+	reg := handlers.Builtin()
+	p := newTestProcess(t, "echo")
+	if err := reg.Register("echo", p); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := reg.Validate("echo", json.RawMessage(`{}`)); err == nil || err.Error() != "say required" {
+		t.Fatalf("validate: %v", err)
+	}
+	if err := reg.Validate("echo", json.RawMessage(`{"say":"hi"}`)); err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+
+	h, _ := reg.Get("echo")
+	ctx := context.Background()
+	res, err := h.Execute(ctx, handlers.Request{RunID: "r1", Attempt: 2, Args: json.RawMessage(`{"say":"hi"}`)})
+	if err != nil || res.Stdout != "hi run=r1 attempt=2" {
+		t.Fatalf("execute: res=%+v err=%v", res, err)
+	}
+
+	_, err = h.Execute(ctx, handlers.Request{Args: json.RawMessage(`{"say":"x","fail":"permanent"}`)})
+	if handlers.Classify(err) != handlers.ClassPermanent {
+		t.Fatalf("want permanent, got %v", err)
+	}
+	_, err = h.Execute(ctx, handlers.Request{Args: json.RawMessage(`{"say":"x","fail":"rate_limited"}`)})
+	if handlers.Classify(err) != handlers.ClassRateLimited || handlers.RetryAfter(err) != 3*time.Second {
+		t.Fatalf("want rate_limited 3s, got %v", err)
+	}
+}
+
+func TestProcess_RestartsAfterCrash(t *testing.T) {
+	p := newTestProcess(t, "echo")
+	ctx := context.Background()
+
+	_, err := p.Execute(ctx, handlers.Request{Args: json.RawMessage(`{"say":"x","fail":"crash"}`)})
+	if handlers.Classify(err) != handlers.ClassTransient {
+		t.Fatalf("crash should be transient, got %v", err)
+	}
+	// wait for the exit to be observed, then the next call restarts it
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		res, err := p.Execute(ctx, handlers.Request{RunID: "r2", Attempt: 1, Args: json.RawMessage(`{"say":"back"}`)})
+		if err == nil {
+			if res.Stdout != "back run=r2 attempt=1" {
+				t.Fatalf("stdout = %q", res.Stdout)
+			}
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("plugin did not come back: %v", err)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+func TestProcess_NameMismatch(t *testing.T) {
+	p := newTestProcess(t, "other")
+	if err := p.Validate(json.RawMessage(`{"say":"hi"}`)); err == nil {
+		t.Fatalf("want name mismatch error")
+	}
+}
+
+func TestLoad(t *testing.T) {
+	reg := handlers.NewRegistry()
+	ps, err := Load(reg, "a=/bin/a, b=/bin/b", log.New(io.Discard, "", 0))
+	if err != nil || len(ps) != 2 || len(reg.Names()) != 2 {
+		t.Fatalf("load: %v %v", ps, err)
+	}
+	if _, err := Load(reg, "broken", nil); err == nil {
+		t.Fatalf("want spec error")
+	}
+}
@@ -0,0 +1,74 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
+
+	"google.golang.org/grpc"
+
+	"github.com/rishansujesh/job-scheduler/internal/worker/handlers"
+	pb "github.com/rishansujesh/job-scheduler/internal/worker/plugin/proto"
+)
+
+// Serve is the main loop of a Go plugin binary: it serves h under name on
+// $JOB_PLUGIN_SOCKET and returns once the worker closes the plugin's stdin
+// (including when the worker dies).
+func Serve(name string, h handlers.Handler) error {
+	sock := os.Getenv(SocketEnv)
+	if sock == "" {
+		return fmt.Errorf("%s not set; plugins are started by the worker", SocketEnv)
+	}
+	lis, err := net.Listen("unix", sock)
+	if err != nil {
+		return err
+	}
+	srv := grpc.NewServer()
+	pb.RegisterHandlerServer(srv, &server{name: name, h: h})
+
+	go func() {
+		_, _ = io.Copy(io.Discard, os.Stdin)
+		srv.GracefulStop()
+	}()
+	return srv.Serve(lis)
+}
+
+type server struct {
+	pb.UnimplementedHandlerServer
+	name string
+	h    handlers.Handler
+}
+
+func (s *server) Describe(context.Context, *pb.DescribeRequest) (*pb.DescribeResponse, error) {
+	return &pb.DescribeResponse{Name: s.name}, nil
+}
+
+func (s *server) Validate(_ context.Context, req *pb.ValidateRequest) (*pb.ValidateResponse, error) {
+	if err := s.h.Validate(json.RawMessage(req.GetArgsJson())); err != nil {
+		return &pb.ValidateResponse{Error: err.Error()}, nil
+	}
+	return &pb.ValidateResponse{}, nil
+}
+
+func (s *server) Execute(ctx context.Context, req *pb.ExecuteRequest) (*pb.ExecuteResponse, error) {
+	res, err := s.h.Execute(ctx, handlers.Request{
+		RunID:   req.GetRunId(),
+		JobID:   req.GetJobId(),
+		Attempt: int(req.GetAttempt()),
+		Args:    json.RawMessage(req.GetArgsJson()),
+	})
+	out := &pb.ExecuteResponse{Stdout: res.Stdout, Stderr: res.Stderr}
+	if res.ExitCode != nil {
+		code := int32(*res.ExitCode)
+		out.ExitCode = &code
+	}
+	if err != nil {
+		out.Error = err.Error()
+		out.ErrorClass = string(handlers.Classify(err))
+		out.RetryAfterMs = handlers.RetryAfter(err).Milliseconds()
+	}
+	return out, nil
+}
@@ -0,0 +1,81 @@
+package worker
+
+import (
+	redisx "github.com/rishansujesh/job-scheduler/internal/redis"
+)
+
+// queue is one named queue the runner consumes: its streams and the delayed
+// set its retries wait in.
+type queue struct {
+	name    string
+	streams redisx.StreamsConfig
+	delayed *redisx.DelayedQueue
+}
+
+// queues lists the queues the runner consumes, building them on first use.
+// The default queue keeps Delayed as its delayed set.
+func (r *Runner) queues() []*queue {
+	r.queuesOnce.Do(func() {
+		names := r.Queues
+		if len(names) == 0 {
+			names = []string{redisx.DefaultQueue}
+		}
+		r.byStream = map[string]*queue{}
+		for _, name := range names {
+			q := &queue{name: name, streams: r.Streams.ForQueue(name)}
+			if q.streams.Retry == r.Streams.Retry {
+				if r.Delayed == nil {
+					r.Delayed = redisx.NewDelayedQueue(r.RDB, r.Streams.RetryDelayed, r.Streams.Retry, r.Logger)
+				}
+				q.delayed = r.Delayed
+			} else {
+				q.delayed = redisx.NewDelayedQueue(r.RDB, q.streams.RetryDelayed, q.streams.Retry, r.Logger)
+			}
+			r.qs = append(r.qs, q)
+			for _, t := range q.streams.Tiers() {
+				for _, s := range t.Streams {
+					r.byStream[s] = q
+				}
+			}
+			r.byStream[q.streams.Retry] = q
+		}
+	})
+	return r.qs
+}
+
+// queueOf returns the queue a message read from stream belongs to, so its
+// retries stay in that queue.
+func (r *Runner) queueOf(stream string) *queue {
+	qs := r.queues()
+	if q, ok := r.byStream[stream]; ok {
+		return q
+	}
+	return qs[0]
+}
+
+// tierOf returns the priority tier of stream, or "" for a retry stream, whose
+// runs come from every tier.
+func (r *Runner) tierOf(stream string) string {
+	for _, t := range r.queueOf(stream).streams.Tiers() {
+		for _, s := range t.Streams {
+			if s == stream {
+				return t.Name
+			}
+		}
+	}
+	return ""
+}
+
+// withPriority returns payload carrying the tier of the stream it was read
+// from, for a run about to wait in the delayed set. Once due it comes back
+// through the retry stream, which sends it on to that tier's stream.
+func (r *Runner) withPriority(stream string, payload map[string]any) map[string]any {
+	if _, ok := payload["priority"]; ok {
+		return payload
+	}
+	tier := r.tierOf(stream)
+	if tier == "" {
+		return payload
+	}
+	return with(payload, map[string]any{"priority": tier})
+}
@@ -0,0 +1,55 @@
+package worker
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/url"
+	"time"
+
+	"github.com/rishansujesh/job-scheduler/internal/jobs"
+	redisx "github.com/rishansujesh/job-scheduler/internal/redis"
+)
+
+// throttle checks every rate limit the run is under: its job's (job, nil if
+// none) and, for HTTP runs, its host's; a limit of 0 or less is ignored.
+// Tokens are taken from all of them or, if any is out, from none; then it
+// returns that limit ("job" or "host:<name>") and how long the run should
+// wait.
+func (r *Runner) throttle(ctx context.Context, jobID, handlerName string, payload map[string]any, job *jobs.RateLimit) (string, time.Duration, error) {
+	var (
+		by     []string
+		limits []redisx.Limit
+	)
+	if l := job; l != nil && l.Limit > 0 {
+		by = append(by, "job")
+		limits = append(limits, redisx.Limit{Name: "job:" + jobID, Limit: l.Limit, Period: l.Period(), Burst: l.BurstOrLimit()})
+	}
+	if host := httpHost(handlerName, payload); host != "" {
+		if l, ok := r.hostLimit(ctx, host); ok && l.Limit > 0 {
+			by = append(by, "host:"+host)
+			limits = append(limits, redisx.Limit{Name: "host:" + host, Limit: l.Limit, Period: l.Period(), Burst: l.BurstOrLimit()})
+		}
+	}
+	i, wait, err := r.Limits.Allow(ctx, limits...)
+	if err != nil || i < 0 {
+		return "", 0, err
+	}
+	// throttled runs of the same limit come back spread over the next few
+	// intervals rather than all at once
+	interval := limits[i].Period / time.Duration(limits[i].Limit)
+	return by[i], wait + rand.N(interval+1), nil
+}
+
+// httpHost is the host an http run calls, or "" for other handlers.
+func httpHost(handlerName string, payload map[string]any) string {
+	if handlerName != "http" {
+		return ""
+	}
+	args, _ := payload["args"].(map[string]any)
+	raw, _ := str(args["url"])
+	u, err := url.Parse(raw)
+	if err != nil {
+		return ""
+	}
+	return jobs.NormalizeHost(u.Hostname())
+}
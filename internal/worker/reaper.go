@@ -0,0 +1,136 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rishansujesh/job-scheduler/internal/jobs"
+	redisx "github.com/rishansujesh/job-scheduler/internal/redis"
+)
+
+// ReaperConfig controls recovery of messages left pending by workers that
+// died (or lost Redis) mid-run.
+type ReaperConfig struct {
+	Interval      time.Duration            // how often to scan the PEL; 0 disables the reaper
+	MinIdle       time.Duration            // idle time after which a message counts as abandoned
+	StreamMinIdle map[string]time.Duration // per-stream overrides of MinIdle
+	MaxDeliveries int64                    // dead-letter once delivered more often than this; 0 = unlimited
+}
+
+func DefaultReaperConfig() ReaperConfig {
+	return ReaperConfig{
+		Interval:      30 * time.Second,
+		MinIdle:       5 * time.Minute,
+		MaxDeliveries: 5,
+	}
+}
+
+func (c ReaperConfig) minIdle(stream string) time.Duration {
+	if d, ok := c.StreamMinIdle[stream]; ok && d > 0 {
+		return d
+	}
+	return c.MinIdle
+}
+
+// reap periodically reclaims abandoned messages until ctx is done.
+func (r *Runner) reap(ctx context.Context) {
+	t := time.NewTicker(r.Reaper.Interval)
+	defer t.Stop()
+	for {
+		r.reapOnce(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// reapOnce claims every message idle past its stream's threshold, from any
+// consumer in the group, and processes it here. It returns how many were
+// claimed.
+func (r *Runner) reapOnce(ctx context.Context) int {
+	claimed := 0
+	for _, stream := range r.streams() {
+		start := "0-0"
+		for {
+			msgs, next, err := redisx.AutoClaimJSON(ctx, r.RDB, stream, r.Group, r.ConsumerName,
+				r.Reaper.minIdle(stream), start, 16)
+			if err != nil {
+				r.Logger.Printf("reaper stream=%s: %v", stream, err)
+				break
+			}
+			for _, m := range msgs {
+				claimed++
+				r.Logger.Printf("reaper: reclaimed stream=%s id=%s deliveries=%d", stream, m.ID, m.Deliveries)
+				if err := r.reclaim(ctx, stream, m); err != nil {
+					r.Logger.Printf("reaper stream=%s id=%s: %v", stream, m.ID, err)
+				}
+			}
+			if next == "" || next == "0-0" {
+				break
+			}
+			start = next
+		}
+	}
+	return claimed
+}
+
+// reclaim handles a message taken over from another consumer. Every earlier
+// delivery ended without an ack, so each counts as a failed attempt.
+func (r *Runner) reclaim(ctx context.Context, stream string, m redisx.DecodedMessage) error {
+	if m.Payload == nil {
+		_, _ = redisx.Ack(ctx, r.RDB, stream, r.Group, m.ID)
+		return fmt.Errorf("invalid message: undecodable payload")
+	}
+	lost := 0
+	if m.Deliveries > 1 {
+		lost = int(m.Deliveries - 1)
+	}
+	attempt := lost
+	if a, ok := toInt(m.Payload["attempt"]); ok {
+		attempt += a
+	}
+
+	if r.Reaper.MaxDeliveries > 0 && m.Deliveries > r.Reaper.MaxDeliveries {
+		runID, _ := str(m.Payload["run_id"])
+		if run, err := r.Store.GetRun(ctx, runID); err == nil && run.Status.Terminal() {
+			// finished before its ack was lost
+			if err := r.advanceWorkflow(ctx, m.Payload); err != nil {
+				return err
+			}
+			_, _ = redisx.Ack(ctx, r.RDB, stream, r.Group, m.ID)
+			return nil
+		}
+		errText := fmt.Sprintf("abandoned by workers %d times", lost)
+		return r.deadLetter(ctx, stream, m, jobs.StatusDead, attempt, errText, nil, "max deliveries exceeded")
+	}
+
+	m.Payload = with(m.Payload, map[string]any{"attempt": attempt})
+	return r.process(ctx, stream, m)
+}
+
+// process runs processMessage while keeping the message's idle time fresh,
+// so that slow handlers are not reclaimed by another worker's reaper.
+func (r *Runner) process(ctx context.Context, stream string, m redisx.DecodedMessage) error {
+	every := r.Reaper.minIdle(stream) / 3
+	if r.Reaper.Interval <= 0 || every <= 0 {
+		return r.processMessage(ctx, stream, m)
+	}
+	hbCtx, stop := context.WithCancel(ctx)
+	defer stop()
+	go func() {
+		t := time.NewTicker(every)
+		defer t.Stop()
+		for {
+			select {
+			case <-hbCtx.Done():
+				return
+			case <-t.C:
+				_ = redisx.Touch(hbCtx, r.RDB, stream, r.Group, r.ConsumerName, m.ID)
+			}
+		}
+	}()
+	return r.processMessage(ctx, stream, m)
+}
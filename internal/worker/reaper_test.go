@@ -0,0 +1,95 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alicebob/miniredis/v2"
+
+	"github.com/rishansujesh/job-scheduler/internal/jobs"
+	redisx "github.com/rishansujesh/job-scheduler/internal/redis"
+)
+
+// strand leaves one message pending on a consumer that never acks it, then
+// moves the clock past the reaper's idle threshold.
+func strand(t *testing.T, r *Runner, mr *miniredis.Miniredis, payload map[string]any) {
+	t.Helper()
+	ctx := context.Background()
+	now := time.Now()
+	mr.SetTime(now)
+	if err := redisx.EnsureGroup(ctx, r.RDB, r.Streams.Adhoc, r.Group); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := redisx.XAddJSON(ctx, r.RDB, r.Streams.Adhoc, payload); err != nil {
+		t.Fatal(err)
+	}
+	msgs, err := redisx.XReadGroupJSON(ctx, r.RDB, redisx.ReadOptions{
+		Streams: []string{r.Streams.Adhoc, ">"}, ConsumerGroup: r.Group, ConsumerName: "dead-worker", Count: 1,
+	})
+	if err != nil || len(msgs) != 1 {
+		t.Fatalf("read: %d %v", len(msgs), err)
+	}
+	mr.SetTime(now.Add(r.Reaper.MinIdle + time.Second))
+}
+
+func TestReaper_ReprocessesStrandedMessage(t *testing.T) {
+	r, mock, mr := newTestRunner(t)
+	r.Reaper = ReaperConfig{Interval: time.Minute, MinIdle: time.Minute, MaxDeliveries: 5}
+	strand(t, r, mr, map[string]any{"run_id": "run", "job_id": "job", "handler": "shell", "args": map[string]any{}})
+
+	mock.ExpectQuery(`FROM job_runs\s+WHERE run_id = \$1`).WillReturnRows(runRow(jobs.StatusRunning))
+	mock.ExpectQuery(`UPDATE job_runs`).WillReturnRows(runRow(jobs.StatusRunning))
+	mock.ExpectExec(`INSERT INTO run_outputs`).WithArgs("run", 2, sqlmock.AnyArg(), sqlmock.AnyArg(),
+		sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+		sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	// the lost delivery counts as attempt 1; this execution is attempt 2
+	mock.ExpectQuery(`UPDATE job_runs`).
+		WithArgs(string(jobs.StatusFailed), sqlmock.AnyArg(), "permanent", sqlmock.AnyArg(), 2, "run").
+		WillReturnRows(runRow(jobs.StatusFailed))
+
+	if n := r.reapOnce(context.Background()); n != 1 {
+		t.Fatalf("reclaimed %d, want 1", n)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+	pending, err := r.RDB.XPending(context.Background(), r.Streams.Adhoc, r.Group).Result()
+	if err != nil || pending.Count != 0 {
+		t.Fatalf("pending after reap = %+v (%v)", pending, err)
+	}
+}
+
+func TestReaper_DeadLettersAfterMaxDeliveries(t *testing.T) {
+	r, mock, mr := newTestRunner(t)
+	r.Reaper = ReaperConfig{Interval: time.Minute, MinIdle: time.Minute, MaxDeliveries: 1}
+	strand(t, r, mr, map[string]any{"run_id": "run", "job_id": "job", "handler": "shell", "args": map[string]any{}})
+
+	mock.ExpectQuery(`FROM job_runs\s+WHERE run_id = \$1`).WillReturnRows(runRow(jobs.StatusRunning))
+	mock.ExpectQuery(`UPDATE job_runs`).
+		WithArgs(string(jobs.StatusDead), sqlmock.AnyArg(), sqlmock.AnyArg(), 1, "run").
+		WillReturnRows(runRow(jobs.StatusDead))
+
+	if n := r.reapOnce(context.Background()); n != 1 {
+		t.Fatalf("reclaimed %d, want 1", n)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+	dlq, err := mr.Stream(r.Streams.DLQ)
+	if err != nil || len(dlq) != 1 {
+		t.Fatalf("want 1 DLQ entry, got %d (%v)", len(dlq), err)
+	}
+}
+
+func TestReaper_LeavesFreshMessagesAlone(t *testing.T) {
+	r, _, mr := newTestRunner(t)
+	r.Reaper = ReaperConfig{Interval: time.Minute, MinIdle: time.Hour}
+	strand(t, r, mr, map[string]any{"run_id": "run", "job_id": "job", "handler": "shell"})
+	r.Reaper.MinIdle = 2 * time.Hour // strand advanced the clock by one hour only
+
+	if n := r.reapOnce(context.Background()); n != 0 {
+		t.Fatalf("reclaimed %d, want 0", n)
+	}
+}
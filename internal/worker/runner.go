@@ -4,8 +4,10 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"sync"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -13,6 +15,7 @@ import (
 	"github.com/rishansujesh/job-scheduler/internal/jobs"
 	redisx "github.com/rishansujesh/job-scheduler/internal/redis"
 	"github.com/rishansujesh/job-scheduler/internal/worker/handlers"
+	"github.com/rishansujesh/job-scheduler/internal/workflow"
 )
 
 type Runner struct {
@@ -20,66 +23,194 @@ type Runner struct {
 	Store        *jobs.Store
 	RDB          *redis.Client
 	Streams      redisx.StreamsConfig
+	Queues       []string // queues to consume; empty = the default queue only
 	Group        string
 	ConsumerName string
-	MaxAttempts  int
+	Retry        jobs.RetryPolicy // default when neither message nor job sets one
+	Output       jobs.OutputLimits
+	Delayed      *redisx.DelayedQueue // default queue's retries wait here until due; built by Start if nil
+	Reaper       ReaperConfig
+	Priority     PriorityConfig
+	Handlers     *handlers.Registry  // nil = built-in shell and http only
+	Slots        *redisx.Semaphore   // per-job max_concurrency; built by Start if nil
+	SlotWait     time.Duration       // how long a run without a free slot waits
+	Limits       *redisx.RateLimiter // per-job and per-host rate limits; built by Start if nil
+	LimitTTL     time.Duration       // how long job and host limits read from the database are reused; 0 = 10s
+	Workflows    *workflow.Engine    // advances workflow runs; built by Start if nil
 	Logger       *log.Logger
+
+	mu      sync.Mutex
+	running map[string]context.CancelCauseFunc // run_id -> cancel, for CancelRun
+
+	limits limitCache
+
+	queuesOnce sync.Once
+	qs         []*queue
+	byStream   map[string]*queue
+}
+
+// PriorityConfig controls how the priority tiers' streams are read.
+type PriorityConfig struct {
+	Strict    bool           // read a tier only when every higher one is empty
+	Weights   map[string]int // messages per tier per round, across its streams; missing = default
+	Consumers int            // goroutines reading the tiers; 0 = 1
+}
+
+// defaultWeights give high-priority runs most of the worker while still
+// reading a few low-priority ones every round.
+var defaultWeights = map[string]int{redisx.TierHigh: 8, redisx.TierNormal: 4, redisx.TierLow: 1}
+
+func DefaultPriorityConfig() PriorityConfig {
+	return PriorityConfig{Weights: defaultWeights, Consumers: 2}
+}
+
+// count is how many messages a round reads from the tier, across its streams.
+func (c PriorityConfig) count(tier string) int64 {
+	if w := c.Weights[tier]; w > 0 {
+		return int64(w)
+	}
+	if w := defaultWeights[tier]; w > 0 {
+		return int64(w)
+	}
+	return 16
+}
+
+// streams lists every stream the runner consumes.
+func (r *Runner) streams() []string {
+	var out []string
+	for _, q := range r.queues() {
+		for _, t := range q.streams.Tiers() {
+			out = append(out, t.Streams...)
+		}
+		out = append(out, q.streams.Retry)
+	}
+	return out
 }
 
 func (r *Runner) Start(ctx context.Context) {
 	// Ensure consumer groups exist
-	_ = redisx.EnsureGroup(ctx, r.RDB, r.Streams.Scheduled, r.Group)
-	_ = redisx.EnsureGroup(ctx, r.RDB, r.Streams.Adhoc, r.Group)
-	_ = redisx.EnsureGroup(ctx, r.RDB, r.Streams.Retry, r.Group)
+	for _, stream := range r.streams() {
+		_ = redisx.EnsureGroup(ctx, r.RDB, stream, r.Group)
+	}
 
-	go r.consume(ctx, r.Streams.Scheduled)
-	go r.consume(ctx, r.Streams.Adhoc)
-	go r.consume(ctx, r.Streams.Retry)
+	for _, q := range r.queues() {
+		go q.delayed.Run(ctx)
+	}
+	if r.Slots == nil {
+		r.Slots = redisx.NewSemaphore(r.RDB, r.Streams.SlotPrefix, DefaultSlotTTL)
+	}
+	if r.Limits == nil {
+		r.Limits = redisx.NewRateLimiter(r.RDB, r.Streams.RatePrefix)
+	}
+	if r.Workflows == nil {
+		r.Workflows = workflow.NewEngine(r.DB, r.Store, r.Streams.Scheduled, nil)
+		r.Workflows.Tiers = r.Streams
+	}
+	if r.Reaper.Interval > 0 {
+		go r.reap(ctx)
+	}
+
+	go r.listenCancels(ctx)
+
+	for _, q := range r.queues() {
+		for i := 0; i < max(r.Priority.Consumers, 1); i++ {
+			go r.consume(ctx, q.streams.Tiers())
+		}
+		go r.consume(ctx, []redisx.Tier{{Name: "retry", Streams: []string{q.streams.Retry}}})
+	}
 }
 
-func (r *Runner) consume(ctx context.Context, stream string) {
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		default:
+// consume reads the tiers, highest first, until ctx is done. Each round
+// takes up to the tier's weight from its streams, so a flood of
+// low-priority runs cannot delay high ones by more than a round and low
+// tiers are not starved. In strict mode a round ends at the first tier that
+// had messages. When every tier is empty it blocks until one is not.
+func (r *Runner) consume(ctx context.Context, tiers []redisx.Tier) {
+	var all []string
+	for _, t := range tiers {
+		all = append(all, t.Streams...)
+	}
+	for turn := 0; ctx.Err() == nil; turn++ {
+		if r.round(ctx, tiers, turn) == 0 {
+			r.read(ctx, all, 1, 5*time.Second)
 		}
+	}
+}
 
-		msgs, err := redisx.XReadGroupJSON(ctx, r.RDB, redisx.ReadOptions{
-			Streams:       []string{stream, ">"},
-			ConsumerGroup: r.Group,
-			ConsumerName:  r.ConsumerName,
-			Count:         16,
-			Block:         5 * time.Second,
-		})
-		if err != nil && err != redis.Nil {
-			r.Logger.Printf("read error stream=%s: %v", stream, err)
-			time.Sleep(500 * time.Millisecond)
-			continue
+// round makes one non-blocking pass over the tiers and returns how many
+// messages it processed. A tier's streams share its weight: each is read
+// for what the ones before it left, starting from a different stream every
+// turn so that none of them is favoured.
+func (r *Runner) round(ctx context.Context, tiers []redisx.Tier, turn int) int {
+	n := 0
+	for _, t := range tiers {
+		got := 0
+		left := r.Priority.count(t.Name)
+		for i := range t.Streams {
+			if left <= 0 {
+				break
+			}
+			k := r.read(ctx, []string{t.Streams[(turn+i)%len(t.Streams)]}, left, -1)
+			got += k
+			left -= int64(k)
 		}
-		if len(msgs) == 0 {
-			continue
+		n += got
+		if got > 0 && r.Priority.Strict {
+			break
 		}
+	}
+	return n
+}
 
-		for _, m := range msgs {
-			if err := r.processMessage(ctx, stream, m); err != nil {
-				r.Logger.Printf("process error stream=%s id=%s: %v", stream, m.ID, err)
-			}
+// read processes up to count new messages from each of streams and returns
+// how many there were. A negative block returns at once.
+func (r *Runner) read(ctx context.Context, streams []string, count int64, block time.Duration) int {
+	args := make([]string, 0, 2*len(streams))
+	args = append(args, streams...)
+	for range streams {
+		args = append(args, ">")
+	}
+	msgs, err := redisx.XReadGroupJSON(ctx, r.RDB, redisx.ReadOptions{
+		Streams:       args,
+		ConsumerGroup: r.Group,
+		ConsumerName:  r.ConsumerName,
+		Count:         count,
+		Block:         block,
+	})
+	if err != nil {
+		if ctx.Err() == nil {
+			r.Logger.Printf("read error streams=%v: %v", streams, err)
+			time.Sleep(500 * time.Millisecond)
 		}
+		return 0
 	}
+	for _, m := range msgs {
+		if err := r.process(ctx, m.Stream, m); err != nil {
+			r.Logger.Printf("process error stream=%s id=%s: %v", m.Stream, m.ID, err)
+		}
+	}
+	return len(msgs)
 }
 
 func (r *Runner) processMessage(ctx context.Context, stream string, m redisx.DecodedMessage) error {
-	// Retry deferral logic for jobs:retry
-	if stream == r.Streams.Retry {
-		if due, ok := m.Payload["available_at_ms"].(float64); ok {
-			nowms := float64(time.Now().UnixMilli())
-			if nowms < due {
-				// Not yet due: re-enqueue and ack this message.
-				_, _ = redisx.XAddJSON(ctx, r.RDB, r.Streams.Retry, m.Payload)
-				_, _ = redisx.Ack(ctx, r.RDB, stream, r.Group, m.ID)
-				return nil
+	// Retries are promoted into the retry stream only once due, but messages
+	// written before the delayed queue existed may still arrive early.
+	if q := r.queueOf(stream); stream == q.streams.Retry {
+		if due, ok := toInt64(m.Payload["available_at_ms"]); ok && time.Now().UnixMilli() < due {
+			if err := q.delayed.Schedule(ctx, m.Payload, time.UnixMilli(due)); err != nil {
+				return err
+			}
+			_, _ = redisx.Ack(ctx, r.RDB, stream, r.Group, m.ID)
+			return nil
+		}
+		// Due retries and deferred runs go back to their tier's stream, so
+		// they are read by priority like new runs rather than all alike.
+		if p, _ := str(m.Payload["priority"]); p != "" {
+			if err := addJSON(ctx, r.RDB, r.Streams.Route(r.Streams.Adhoc, q.name, p), m.Payload); err != nil {
+				return err
 			}
+			_, _ = redisx.Ack(ctx, r.RDB, stream, r.Group, m.ID)
+			return nil
 		}
 	}
 
@@ -87,17 +218,15 @@ func (r *Runner) processMessage(ctx context.Context, stream string, m redisx.Dec
 	jobID, _ := str(m.Payload["job_id"])
 	handlerName, _ := str(m.Payload["handler"])
 
-	// Ensure run row exists; if not, insert queued row using idempotency_key=run_id
 	if runID == "" || jobID == "" || handlerName == "" {
 		_, _ = redisx.Ack(ctx, r.RDB, stream, r.Group, m.ID)
 		return fmt.Errorf("invalid message: missing fields")
 	}
-	_, err := r.Store.UpdateRunStatus(ctx, jobs.UpdateRunStatusParams{
-		RunID:  runID,
-		Status: jobs.StatusRunning,
-	})
+	// Ensure run row exists. The outbox relay delivers at least once, so a
+	// duplicate message for a run that already finished is acked and dropped.
+	run, err := r.Store.GetRun(ctx, runID)
 	if err == jobs.ErrNotFound {
-		// insert then set running
+		// insert queued row using idempotency_key=run_id
 		if _, err2 := r.Store.InsertRun(ctx, jobs.InsertRunParams{
 			JobID:          jobID,
 			RunID:          runID,
@@ -106,93 +235,304 @@ func (r *Runner) processMessage(ctx context.Context, stream string, m redisx.Dec
 		}); err2 != nil {
 			return err2
 		}
-		if _, err3 := r.Store.UpdateRunStatus(ctx, jobs.UpdateRunStatusParams{
-			RunID:  runID,
-			Status: jobs.StatusRunning,
-		}); err3 != nil {
-			return err3
-		}
 	} else if err != nil {
 		return err
+	} else if run.Status.Terminal() {
+		// a previous delivery may have died before advancing its workflow
+		if err := r.advanceWorkflow(ctx, m.Payload); err != nil {
+			return err
+		}
+		_, _ = redisx.Ack(ctx, r.RDB, stream, r.Group, m.ID)
+		return nil
 	}
 
-	// Execute handler
-	var execErr error
-	switch handlerName {
-	case "shell":
-		var a handlers.ShellArgs
-		bytesArg, _ := json.Marshal(m.Payload["args"])
-		_ = json.Unmarshal(bytesArg, &a)
-		_, execErr = handlers.RunShell(ctx, a)
-	case "http":
-		var a handlers.HTTPArgs
-		bytesArg, _ := json.Marshal(m.Payload["args"])
-		_ = json.Unmarshal(bytesArg, &a)
-		_, err := handlers.RunHTTP(ctx, a)
-		execErr = err
-	default:
-		execErr = fmt.Errorf("unknown handler: %s", handlerName)
+	// Enforce the job's max_concurrency across the fleet. Runs that find all
+	// slots taken wait in the delayed queue without using an attempt.
+	limits := r.limitsFor(ctx, jobID, m.Payload)
+	if limit := limits.maxConcurrency; limit > 0 {
+		ok, err := r.Slots.Acquire(ctx, jobID, limit, runID, r.ConsumerName)
+		if err != nil {
+			return fmt.Errorf("acquire slot: %w", err)
+		}
+		if !ok {
+			return r.deferRun(ctx, stream, m, r.slotWait())
+		}
+		release := r.holdSlot(ctx, jobID, runID)
+		defer release()
 	}
 
-	// Update DB and ack / retry / dlq
-	if execErr == nil {
-		now := timePtr(time.Now().UTC())
-		_, _ = r.Store.UpdateRunStatus(ctx, jobs.UpdateRunStatusParams{
-			RunID:      runID,
-			Status:     jobs.StatusSuccess,
-			FinishedAt: now,
-		})
+	// Rate limits go after the slot so that only runs able to start take a
+	// token. Throttled runs wait like slotless ones and the run records it.
+	if by, wait, err := r.throttle(ctx, jobID, handlerName, m.Payload, limits.rateLimit); err != nil {
+		return fmt.Errorf("rate limit: %w", err)
+	} else if wait > 0 {
+		if err := r.Store.RecordThrottle(ctx, runID, by); err != nil {
+			r.Logger.Printf("record throttle run=%s: %v", runID, err)
+		}
+		return r.deferRun(ctx, stream, m, wait)
+	}
+
+	// Register before marking running: once worker_id is visible, CancelRun
+	// may signal us at any time.
+	runCtx, cancelRun := context.WithCancelCause(ctx)
+	defer cancelRun(nil)
+	r.track(runID, cancelRun)
+	defer r.untrack(runID)
+
+	if _, err := r.Store.UpdateRunStatus(ctx, jobs.UpdateRunStatusParams{
+		RunID:    runID,
+		Status:   jobs.StatusRunning,
+		WorkerID: &r.ConsumerName,
+	}); err == jobs.ErrNotFound {
+		// canceled while queued
+		if err := r.advanceWorkflow(ctx, m.Payload); err != nil {
+			return err
+		}
 		_, _ = redisx.Ack(ctx, r.RDB, stream, r.Group, m.ID)
 		return nil
+	} else if err != nil {
+		return err
 	}
 
-	// Failure path
+	// Attempt number of this execution (payload carries failures so far)
 	attempt := 0
 	if a, ok := toInt(m.Payload["attempt"]); ok {
 		attempt = a
 	}
 	attempt++
 
-	if attempt >= r.MaxAttempts {
-		// DLQ
-		_ = addJSON(ctx, r.RDB, r.Streams.DLQ, with(m.Payload, map[string]any{
-			"attempt": attempt,
-			"error":   execErr.Error(),
-		}))
+	// Execute handler
+	var res handlers.Result
+	h, execErr := r.registry().Get(handlerName)
+	if execErr != nil {
+		execErr = handlers.Permanent(execErr)
+	} else {
+		args, _ := json.Marshal(m.Payload["args"])
+		res, execErr = h.Execute(runCtx, handlers.Request{
+			RunID:   runID,
+			JobID:   jobID,
+			Attempt: attempt,
+			Args:    args,
+		})
+	}
+	r.saveOutput(ctx, runID, attempt, res, execErr)
+
+	// Canceled mid-run: the row already says canceled; just keep the output.
+	if errors.Is(context.Cause(runCtx), errRunCanceled) {
+		r.Logger.Printf("run %s canceled", runID)
+		if err := r.advanceWorkflow(ctx, m.Payload); err != nil {
+			return err
+		}
+		_, _ = redisx.Ack(ctx, r.RDB, stream, r.Group, m.ID)
+		return nil
+	}
+
+	// Update DB and ack / retry / dlq
+	if execErr == nil {
 		now := timePtr(time.Now().UTC())
-		errText := execErr.Error()
 		_, _ = r.Store.UpdateRunStatus(ctx, jobs.UpdateRunStatusParams{
 			RunID:      runID,
-			Status:     jobs.StatusDead,
-			ErrorText:  &errText,
+			Status:     jobs.StatusSuccess,
 			FinishedAt: now,
-			Attempts:   &attempt,
 		})
+		if err := r.advanceWorkflow(ctx, m.Payload); err != nil {
+			return err
+		}
 		_, _ = redisx.Ack(ctx, r.RDB, stream, r.Group, m.ID)
 		return nil
 	}
 
-	// Retry — exponential backoff (base 1s, cap 30s)
-	backoff := time.Duration(1<<min(attempt-1, 5)) * time.Second
-	nextAvail := time.Now().Add(backoff).UnixMilli()
-	_ = addJSON(ctx, r.RDB, r.Streams.Retry, with(m.Payload, map[string]any{
+	// Failure path
+	class := handlers.Classify(execErr)
+	classText := string(class)
+	errText := execErr.Error()
+
+	var policy jobs.RetryPolicy
+	giveUp := class == handlers.ClassPermanent
+	if !giveUp {
+		policy = r.retryPolicy(ctx, m.Payload, jobID)
+		giveUp = attempt >= policy.MaxAttempts
+	}
+
+	if giveUp {
+		// Permanent errors fail immediately; exhausted retries are dead.
+		status := jobs.StatusDead
+		reason := "max attempts exhausted"
+		if class == handlers.ClassPermanent {
+			status = jobs.StatusFailed
+			reason = "permanent error"
+		}
+		return r.deadLetter(ctx, stream, m, status, attempt, errText, &classText, reason)
+	}
+
+	// Retry per policy; a rate-limited failure waits at least as long as
+	// the server asked.
+	backoff := policy.Backoff(attempt)
+	if ra := handlers.RetryAfter(execErr); ra > backoff {
+		backoff = ra
+	}
+	nextAvail := time.Now().Add(backoff)
+	if err := r.queueOf(stream).delayed.Schedule(ctx, with(r.withPriority(stream, m.Payload), map[string]any{
 		"attempt":         attempt,
 		"backoff_ms":      backoff.Milliseconds(),
-		"available_at_ms": nextAvail,
-		"error":           execErr.Error(),
-	}))
+		"available_at_ms": nextAvail.UnixMilli(),
+		"error":           errText,
+		"error_class":     classText,
+	}), nextAvail); err != nil {
+		// leave the message pending; it is redelivered rather than lost
+		return fmt.Errorf("schedule retry: %w", err)
+	}
 	// Update DB to retried
-	errText := execErr.Error()
 	_, _ = r.Store.UpdateRunStatus(ctx, jobs.UpdateRunStatusParams{
-		RunID:     runID,
-		Status:    jobs.StatusRetried,
-		ErrorText: &errText,
-		Attempts:  &attempt,
+		RunID:      runID,
+		Status:     jobs.StatusRetried,
+		ErrorText:  &errText,
+		ErrorClass: &classText,
+		Attempts:   &attempt,
+	})
+	_, _ = redisx.Ack(ctx, r.RDB, stream, r.Group, m.ID)
+	return nil
+}
+
+var errRunCanceled = errors.New("run canceled")
+
+// listenCancels receives CancelRun signals addressed to this worker.
+func (r *Runner) listenCancels(ctx context.Context) {
+	for ctx.Err() == nil {
+		err := redisx.SubscribeCancels(ctx, r.RDB, r.Streams.CancelPrefix, r.ConsumerName, func(runID string) {
+			r.mu.Lock()
+			cancel := r.running[runID]
+			r.mu.Unlock()
+			if cancel != nil {
+				cancel(errRunCanceled)
+			}
+		})
+		if err != nil && ctx.Err() == nil {
+			r.Logger.Printf("cancel subscription: %v", err)
+			time.Sleep(time.Second)
+		}
+	}
+}
+
+func (r *Runner) track(runID string, cancel context.CancelCauseFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.running == nil {
+		r.running = map[string]context.CancelCauseFunc{}
+	}
+	r.running[runID] = cancel
+}
+
+func (r *Runner) untrack(runID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.running, runID)
+}
+
+var builtinHandlers = handlers.Builtin()
+
+func (r *Runner) registry() *handlers.Registry {
+	if r.Handlers != nil {
+		return r.Handlers
+	}
+	return builtinHandlers
+}
+
+// deadLetter moves a message to the DLQ, records the final run status and
+// acks the message. The DLQ entry keeps the stream and queue the message was
+// read from, and its priority when known, so it can be requeued where it
+// came from.
+func (r *Runner) deadLetter(ctx context.Context, stream string, m redisx.DecodedMessage, status jobs.JobRunStatus, attempt int, errText string, class *string, reason string) error {
+	extra := map[string]any{
+		"attempt": attempt,
+		"error":   errText,
+		"reason":  reason,
+		"stream":  stream,
+		"queue":   r.queueOf(stream).name,
+	}
+	if class != nil {
+		extra["error_class"] = *class
+	}
+	if _, ok := m.Payload["priority"]; !ok {
+		if tier := r.tierOf(stream); tier != "" {
+			extra["priority"] = tier
+		}
+	}
+	_ = addJSON(ctx, r.RDB, r.Streams.DLQ, with(m.Payload, extra))
+
+	runID, _ := str(m.Payload["run_id"])
+	now := timePtr(time.Now().UTC())
+	_, _ = r.Store.UpdateRunStatus(ctx, jobs.UpdateRunStatusParams{
+		RunID:      runID,
+		Status:     status,
+		ErrorText:  &errText,
+		ErrorClass: class,
+		FinishedAt: now,
+		Attempts:   &attempt,
 	})
+	if err := r.advanceWorkflow(ctx, m.Payload); err != nil {
+		return err
+	}
 	_, _ = redisx.Ack(ctx, r.RDB, stream, r.Group, m.ID)
 	return nil
 }
 
+// advanceWorkflow moves the run's workflow on once the run is final. On error
+// the message stays unacked, so the redelivery advances it instead.
+func (r *Runner) advanceWorkflow(ctx context.Context, payload map[string]any) error {
+	wfRunID, _ := str(payload["workflow_run_id"])
+	if wfRunID == "" || r.Workflows == nil {
+		return nil
+	}
+	if err := r.Workflows.Advance(ctx, wfRunID); err != nil {
+		return fmt.Errorf("advance workflow run %s: %w", wfRunID, err)
+	}
+	return nil
+}
+
+// retryPolicy resolves the policy for a failed run: the one carried on the
+// message, else the job's current definition (messages enqueued before the
+// policy existed, DLQ requeues), else the runner default.
+func (r *Runner) retryPolicy(ctx context.Context, payload map[string]any, jobID string) jobs.RetryPolicy {
+	def := r.Retry.WithDefaults(jobs.DefaultRetryPolicy())
+	if raw, ok := payload["retry_policy"]; ok && raw != nil {
+		var p jobs.RetryPolicy
+		b, _ := json.Marshal(raw)
+		if err := json.Unmarshal(b, &p); err == nil {
+			return p.WithDefaults(def)
+		}
+	}
+	if j, err := r.Store.GetJob(ctx, jobID); err == nil && j.RetryPolicy != nil {
+		return j.RetryPolicy.WithDefaults(def)
+	} else if err != nil {
+		r.Logger.Printf("load retry policy job=%s: %v (using default)", jobID, err)
+	}
+	return def
+}
+
+// saveOutput persists what the handler produced for this attempt. Failing to
+// store output must not fail the run, so errors are only logged.
+func (r *Runner) saveOutput(ctx context.Context, runID string, attempt int, res handlers.Result, execErr error) {
+	o := jobs.RunOutput{
+		RunID:    runID,
+		Attempt:  attempt,
+		ExitCode: res.ExitCode,
+		Stdout:   res.Stdout,
+		Stderr:   res.Stderr,
+	}
+	if res.HTTPStatus != 0 {
+		code := res.HTTPStatus
+		o.HTTPStatus = &code
+	}
+	if execErr != nil {
+		errText := execErr.Error()
+		o.ErrorText = &errText
+	}
+	if err := r.Store.SaveRunOutput(ctx, o, r.Output); err != nil {
+		r.Logger.Printf("save output run=%s attempt=%d: %v", runID, attempt, err)
+	}
+}
+
 // -------- helpers --------
 
 func addJSON(ctx context.Context, rdb *redis.Client, stream string, payload map[string]any) error {
@@ -228,10 +568,16 @@ func toInt(v any) (int, bool) {
 		return 0, false
 	}
 }
-func min(a, b int) int {
-	if a < b {
-		return a
+func toInt64(v any) (int64, bool) {
+	switch t := v.(type) {
+	case float64:
+		return int64(t), true
+	case int64:
+		return t, true
+	case int:
+		return int64(t), true
+	default:
+		return 0, false
 	}
-	return b
 }
 func timePtr(t time.Time) *time.Time { return &t }
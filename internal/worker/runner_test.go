@@ -0,0 +1,624 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/rishansujesh/job-scheduler/internal/jobs"
+	redisx "github.com/rishansujesh/job-scheduler/internal/redis"
+	"github.com/rishansujesh/job-scheduler/internal/workflow"
+)
+
+var runCols = []string{"id", "job_id", "run_id", "started_at", "finished_at", "status", "attempts", "error_text", "error_class", "worker_id", "idempotency_key", "scheduled_for", "throttle_count", "throttled_at", "throttled_by"}
+
+var jobCols = []string{"id", "name", "type", "handler", "args", "enabled", "retry_policy", "max_concurrency", "priority", "queue", "rate_limit", "created_at", "updated_at"}
+
+func runRow(status jobs.JobRunStatus) *sqlmock.Rows {
+	return sqlmock.NewRows(runCols).
+		AddRow(1, "job", "run", time.Now().UTC(), nil, string(status), 0, nil, nil, nil, "key", nil, 0, nil, nil)
+}
+
+func newTestRunner(t *testing.T) (*Runner, sqlmock.Sqlmock, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	r := &Runner{
+		DB:    db,
+		Store: jobs.NewStore(db),
+		RDB:   rdb,
+		Streams: redisx.StreamsConfig{
+			Scheduled: "jobs:scheduled",
+			Adhoc:     "jobs:adhoc",
+			Retry:     "jobs:retry",
+			DLQ:       "jobs:dlq",
+
+			CancelPrefix: "jobs:cancel:",
+		},
+		Group:        "cg:workers",
+		ConsumerName: "test",
+		Retry:        jobs.DefaultRetryPolicy(),
+		Logger:       log.New(io.Discard, "", 0),
+	}
+	r.Delayed = redisx.NewDelayedQueue(rdb, "jobs:retry:delayed", r.Streams.Retry, r.Logger)
+	r.Slots = redisx.NewSemaphore(rdb, "jobs:slots:", time.Minute)
+	r.Limits = redisx.NewRateLimiter(rdb, "jobs:rate:")
+	return r, mock, mr
+}
+
+func message(stream string, payload map[string]any) redisx.DecodedMessage {
+	return redisx.DecodedMessage{Stream: stream, ID: "1-0", Payload: payload}
+}
+
+func TestProcessMessage_PermanentErrorSkipsRetries(t *testing.T) {
+	r, mock, mr := newTestRunner(t)
+
+	mock.ExpectQuery(`FROM job_runs\s+WHERE run_id = \$1`).WillReturnRows(runRow(jobs.StatusQueued))
+	mock.ExpectQuery(`UPDATE job_runs`).WillReturnRows(runRow(jobs.StatusRunning))
+	mock.ExpectExec(`INSERT INTO run_outputs`).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(`UPDATE job_runs\s+SET status = \$1, error_text = \$2, error_class = \$3`).
+		WithArgs(string(jobs.StatusFailed), sqlmock.AnyArg(), "permanent", sqlmock.AnyArg(), 1, "run").
+		WillReturnRows(runRow(jobs.StatusFailed))
+
+	// missing command is a validation error: permanent
+	err := r.processMessage(context.Background(), r.Streams.Adhoc, message(r.Streams.Adhoc, map[string]any{
+		"run_id": "run", "job_id": "job", "handler": "shell", "args": map[string]any{},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+	if mr.Exists(r.Streams.Retry) {
+		t.Fatalf("permanent failure must not be retried")
+	}
+	dlq, err := mr.Stream(r.Streams.DLQ)
+	if err != nil || len(dlq) != 1 {
+		t.Fatalf("want 1 DLQ entry, got %d (%v)", len(dlq), err)
+	}
+}
+
+func TestProcessMessage_TransientErrorRetries(t *testing.T) {
+	r, mock, mr := newTestRunner(t)
+
+	mock.ExpectQuery(`FROM job_runs\s+WHERE run_id = \$1`).WillReturnRows(runRow(jobs.StatusQueued))
+	mock.ExpectQuery(`UPDATE job_runs`).WillReturnRows(runRow(jobs.StatusRunning))
+	mock.ExpectExec(`INSERT INTO run_outputs`).WillReturnResult(sqlmock.NewResult(1, 1))
+	// no policy on the message: falls back to the job row
+	mock.ExpectQuery(`FROM jobs WHERE id = \$1`).WillReturnRows(sqlmock.NewRows(jobCols).
+		AddRow("job", "j", "adhoc", "shell", []byte(`{}`), true, []byte(`{"max_attempts":3,"strategy":"fixed","base_delay_ms":10}`), 0, "normal", "default", nil, time.Now(), time.Now()))
+	mock.ExpectQuery(`UPDATE job_runs`).
+		WithArgs(string(jobs.StatusRetried), sqlmock.AnyArg(), "transient", 1, "run").
+		WillReturnRows(runRow(jobs.StatusRetried))
+
+	err := r.processMessage(context.Background(), r.Streams.Adhoc, message(r.Streams.Adhoc, map[string]any{
+		"run_id": "run", "job_id": "job", "handler": "shell", "args": map[string]any{"command": "exit 1"},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+	if mr.Exists(r.Streams.DLQ) {
+		t.Fatalf("transient failure should not hit the DLQ on first attempt")
+	}
+	// the retry waits in the delayed queue, not in the stream
+	if mr.Exists(r.Streams.Retry) {
+		t.Fatalf("retry must not be written to the stream before it is due")
+	}
+	if total, _, _ := r.Delayed.Depth(context.Background()); total != 1 {
+		t.Fatalf("delayed depth = %d, want 1", total)
+	}
+}
+
+func TestProcessMessage_EarlyRetryIsDeferredNotReadded(t *testing.T) {
+	r, mock, mr := newTestRunner(t)
+
+	due := time.Now().Add(time.Minute).UnixMilli()
+	err := r.processMessage(context.Background(), r.Streams.Retry, message(r.Streams.Retry, map[string]any{
+		"run_id": "run", "job_id": "job", "handler": "shell", "available_at_ms": float64(due),
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+	if mr.Exists(r.Streams.Retry) {
+		t.Fatalf("early retry must not be re-added to the stream")
+	}
+	next, ok, err := r.Delayed.NextDue(context.Background())
+	if err != nil || !ok || next.UnixMilli() != due {
+		t.Fatalf("next due = %v ok=%v err=%v, want %d", next, ok, err, due)
+	}
+}
+
+func TestProcessMessage_RetryPolicyFromMessage(t *testing.T) {
+	r, mock, mr := newTestRunner(t)
+
+	mock.ExpectQuery(`FROM job_runs\s+WHERE run_id = \$1`).WillReturnRows(runRow(jobs.StatusQueued))
+	mock.ExpectQuery(`UPDATE job_runs`).WillReturnRows(runRow(jobs.StatusRunning))
+	mock.ExpectExec(`INSERT INTO run_outputs`).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(`UPDATE job_runs`).
+		WithArgs(string(jobs.StatusDead), sqlmock.AnyArg(), "transient", sqlmock.AnyArg(), 1, "run").
+		WillReturnRows(runRow(jobs.StatusDead))
+
+	// max_attempts=1 on the message: the first transient failure is final,
+	// and the job row is never consulted.
+	err := r.processMessage(context.Background(), r.Streams.Adhoc, message(r.Streams.Adhoc, map[string]any{
+		"run_id": "run", "job_id": "job", "handler": "shell", "args": map[string]any{"command": "exit 1"},
+		"retry_policy": map[string]any{"max_attempts": 1},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+	if mr.Exists(r.Streams.Retry) {
+		t.Fatalf("exhausted run must not be retried")
+	}
+}
+
+func TestProcessMessage_DefersWhenNoSlotWithoutUsingAttempt(t *testing.T) {
+	r, mock, mr := newTestRunner(t)
+	ctx := context.Background()
+
+	// another run already holds the only slot
+	if ok, _ := r.Slots.Acquire(ctx, "job", 1, "other-run", "other-worker"); !ok {
+		t.Fatal("setup acquire")
+	}
+	mock.ExpectQuery(`FROM job_runs\s+WHERE run_id = \$1`).WillReturnRows(runRow(jobs.StatusQueued))
+	expectJobLimits(mock, 1)
+
+	err := r.processMessage(ctx, r.Streams.Adhoc, message(r.Streams.Adhoc, map[string]any{
+		"run_id": "run", "job_id": "job", "handler": "shell", "args": map[string]any{"command": "true"},
+		"attempt": float64(2),
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// not marked running, no output, no attempt consumed
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+	if mr.Exists(r.Streams.DLQ) {
+		t.Fatalf("deferral is not a failure")
+	}
+	members, err := mr.ZMembers("jobs:retry:delayed")
+	if err != nil || len(members) != 1 {
+		t.Fatalf("delayed = %v (%v)", members, err)
+	}
+	var p map[string]any
+	_ = json.Unmarshal([]byte(members[0]), &p)
+	if p["attempt"] != float64(2) {
+		t.Fatalf("attempt changed: %v", p["attempt"])
+	}
+}
+
+func TestProcessMessage_ThrottledByHostWaitsAndIsRecorded(t *testing.T) {
+	r, mock, mr := newTestRunner(t)
+	ctx := context.Background()
+
+	// the host's only token this minute is gone
+	if i, _, _ := r.Limits.Allow(ctx, redisx.Limit{Name: "host:api.example.com", Limit: 1, Period: time.Minute, Burst: 1}); i != -1 {
+		t.Fatal("setup allow")
+	}
+	mock.ExpectQuery(`FROM job_runs\s+WHERE run_id = \$1`).WillReturnRows(runRow(jobs.StatusQueued))
+	expectJobLimits(mock, 0)
+	expectHostLimits(mock)
+	mock.ExpectExec(`UPDATE job_runs\s+SET throttle_count = throttle_count \+ 1`).
+		WithArgs("run", "host:api.example.com").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := r.processMessage(ctx, r.Streams.Adhoc, message(r.Streams.Adhoc, map[string]any{
+		"run_id": "run", "job_id": "job", "handler": "http", "args": map[string]any{"url": "https://API.Example.com/v1/sync"},
+		"attempt": float64(2),
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// throttle recorded, not marked running, no attempt consumed
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+	members, err := mr.ZMembers("jobs:retry:delayed")
+	if err != nil || len(members) != 1 {
+		t.Fatalf("delayed = %v (%v)", members, err)
+	}
+	if due, _ := mr.ZScore("jobs:retry:delayed", members[0]); time.Until(time.UnixMilli(int64(due))) < 50*time.Second {
+		t.Fatalf("run comes back before the host has a token")
+	}
+	var p map[string]any
+	_ = json.Unmarshal([]byte(members[0]), &p)
+	if p["attempt"] != float64(2) {
+		t.Fatalf("attempt changed: %v", p["attempt"])
+	}
+}
+
+// expectJobLimits expects the worker to read the job's limits from its row.
+func expectJobLimits(mock sqlmock.Sqlmock, maxConcurrency int) {
+	mock.ExpectQuery(`FROM jobs WHERE id = \$1`).WillReturnRows(sqlmock.NewRows(jobCols).
+		AddRow("job", "j", "adhoc", "shell", []byte(`{}`), true, nil, maxConcurrency, "normal", "default", nil, time.Now(), time.Now()))
+}
+
+// expectHostLimits expects the worker to load a limit of one call a minute
+// for api.example.com.
+func expectHostLimits(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(`FROM host_rate_limits`).WillReturnRows(sqlmock.NewRows([]string{"host", "rate_limit", "updated_at"}).
+		AddRow("api.example.com", []byte(`{"limit":1,"period_ms":60000}`), time.Now()))
+}
+
+func TestThrottle_HostLimitDoesNotSpendJobTokens(t *testing.T) {
+	r, mock, _ := newTestRunner(t)
+	expectHostLimits(mock)
+	ctx := context.Background()
+	payload := map[string]any{"args": map[string]any{"url": "https://api.example.com/v1/sync"}}
+	job := &jobs.RateLimit{Limit: 2, PeriodMS: 60000}
+
+	if by, wait, err := r.throttle(ctx, "job", "http", payload, job); err != nil || wait != 0 {
+		t.Fatalf("first run throttled by %q: %v", by, err)
+	}
+	// the host is out for a minute; redeliveries must not use up the job's
+	// second token
+	for i := 0; i < 3; i++ {
+		if by, wait, _ := r.throttle(ctx, "job", "http", payload, job); by != "host:api.example.com" || wait <= 0 {
+			t.Fatalf("attempt %d: throttled by %q for %v", i, by, wait)
+		}
+	}
+	if by, wait, _ := r.throttle(ctx, "job", "shell", map[string]any{}, job); wait != 0 {
+		t.Fatalf("job's own token was spent: throttled by %q", by)
+	}
+}
+
+func TestProcessMessage_ReleasesSlotAfterRun(t *testing.T) {
+	r, mock, _ := newTestRunner(t)
+	ctx := context.Background()
+
+	mock.ExpectQuery(`FROM job_runs\s+WHERE run_id = \$1`).WillReturnRows(runRow(jobs.StatusQueued))
+	expectJobLimits(mock, 1)
+	mock.ExpectQuery(`UPDATE job_runs`).WillReturnRows(runRow(jobs.StatusRunning))
+	mock.ExpectExec(`INSERT INTO run_outputs`).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(`UPDATE job_runs`).WithArgs(string(jobs.StatusSuccess), sqlmock.AnyArg(), "run").
+		WillReturnRows(runRow(jobs.StatusSuccess))
+
+	err := r.processMessage(ctx, r.Streams.Adhoc, message(r.Streams.Adhoc, map[string]any{
+		"run_id": "run", "job_id": "job", "handler": "shell", "args": map[string]any{"command": "true"},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+	if holders, _ := r.Slots.Holders(ctx, "job"); len(holders) != 0 {
+		t.Fatalf("slot not released: %+v", holders)
+	}
+}
+
+func TestProcessMessage_CancelStopsRunningHandler(t *testing.T) {
+	r, mock, _ := newTestRunner(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go r.listenCancels(ctx)
+
+	mock.ExpectQuery(`FROM job_runs\s+WHERE run_id = \$1`).WillReturnRows(runRow(jobs.StatusQueued))
+	mock.ExpectQuery(`UPDATE job_runs`).WithArgs(string(jobs.StatusRunning), "test", "run").
+		WillReturnRows(runRow(jobs.StatusRunning))
+	mock.ExpectExec(`INSERT INTO run_outputs`).WillReturnResult(sqlmock.NewResult(1, 1))
+	// no final status update: the API already marked the run canceled
+
+	done := make(chan error, 1)
+	start := time.Now()
+	go func() {
+		done <- r.processMessage(ctx, r.Streams.Adhoc, message(r.Streams.Adhoc, map[string]any{
+			"run_id": "run", "job_id": "job", "handler": "shell", "args": map[string]any{"command": "sleep 30"},
+		}))
+	}()
+
+	// keep signalling until the run is registered and the handler stops
+	for {
+		if _, err := redisx.PublishCancel(ctx, r.RDB, r.Streams.CancelPrefix, "test", "run"); err != nil {
+			t.Fatal(err)
+		}
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Fatal(err)
+			}
+			if time.Since(start) > 10*time.Second {
+				t.Fatalf("handler was not killed promptly")
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+			return
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+}
+
+func TestProcessMessage_SkipsRunCanceledWhileQueued(t *testing.T) {
+	r, mock, _ := newTestRunner(t)
+
+	mock.ExpectQuery(`FROM job_runs\s+WHERE run_id = \$1`).WillReturnRows(runRow(jobs.StatusCanceled))
+
+	err := r.processMessage(context.Background(), r.Streams.Adhoc, message(r.Streams.Adhoc, map[string]any{
+		"run_id": "run", "job_id": "job", "handler": "shell", "args": map[string]any{"command": "echo no"},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProcessMessage_WorkflowAdvanceFailureLeavesMessagePending(t *testing.T) {
+	r, mock, _ := newTestRunner(t)
+	r.Workflows = workflow.NewEngine(r.DB, r.Store, r.Streams.Scheduled, nil)
+	ctx := context.Background()
+	if err := redisx.EnsureGroup(ctx, r.RDB, r.Streams.Adhoc, r.Group); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := redisx.XAddJSON(ctx, r.RDB, r.Streams.Adhoc, map[string]any{}); err != nil {
+		t.Fatal(err)
+	}
+	msgs, err := redisx.XReadGroupJSON(ctx, r.RDB, redisx.ReadOptions{
+		Streams: []string{r.Streams.Adhoc, ">"}, ConsumerGroup: r.Group, ConsumerName: r.ConsumerName, Count: 1,
+	})
+	if err != nil || len(msgs) != 1 {
+		t.Fatalf("read: %v %v", msgs, err)
+	}
+	m := msgs[0]
+	m.Payload = map[string]any{
+		"run_id": "run", "job_id": "job", "handler": "shell", "args": map[string]any{"command": "true"},
+		"workflow_run_id": "wr",
+	}
+
+	mock.ExpectQuery(`FROM job_runs\s+WHERE run_id = \$1`).WillReturnRows(runRow(jobs.StatusQueued))
+	mock.ExpectQuery(`UPDATE job_runs`).WillReturnRows(runRow(jobs.StatusRunning))
+	mock.ExpectExec(`INSERT INTO run_outputs`).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(`UPDATE job_runs`).WithArgs(string(jobs.StatusSuccess), sqlmock.AnyArg(), "run").
+		WillReturnRows(runRow(jobs.StatusSuccess))
+	mock.ExpectBegin().WillReturnError(errors.New("db down"))
+
+	if err := r.processMessage(ctx, r.Streams.Adhoc, m); err == nil {
+		t.Fatalf("want advance error")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+	// unacked, so the reaper redelivers it and the retry advances the workflow
+	pending, err := r.RDB.XPending(ctx, r.Streams.Adhoc, r.Group).Result()
+	if err != nil || pending.Count != 1 {
+		t.Fatalf("want message pending, got %+v (%v)", pending, err)
+	}
+}
+
+func TestRound_WeightsTiers(t *testing.T) {
+	for _, tc := range []struct {
+		strict            bool
+		high, normal, low int64
+	}{
+		{strict: false, high: 8, normal: 4, low: 1},
+		{strict: true, high: 8, normal: 0, low: 0},
+	} {
+		r, _, _ := newTestRunner(t)
+		r.Streams.High, r.Streams.Low = "jobs:high", "jobs:low"
+		r.Priority = DefaultPriorityConfig()
+		r.Priority.Strict = tc.strict
+		ctx := context.Background()
+		for _, s := range r.streams() {
+			_ = redisx.EnsureGroup(ctx, r.RDB, s, r.Group)
+		}
+		for i := 0; i < 20; i++ {
+			for _, s := range []string{r.Streams.High, r.Streams.Scheduled, r.Streams.Adhoc, r.Streams.Low} {
+				_, _ = redisx.XAddJSON(ctx, r.RDB, s, map[string]any{"run_id": "run", "job_id": "job", "handler": "shell"})
+			}
+		}
+
+		// the store has no expectations, so every message stays pending
+		pending := func(s string) int64 {
+			p, err := r.RDB.XPending(ctx, s, r.Group).Result()
+			if err != nil {
+				t.Fatal(err)
+			}
+			return p.Count
+		}
+		r.round(ctx, r.Streams.Tiers(), 0)
+		// the normal tier's two streams share its weight
+		got := map[string]int64{
+			"high":   pending(r.Streams.High),
+			"normal": pending(r.Streams.Scheduled) + pending(r.Streams.Adhoc),
+			"low":    pending(r.Streams.Low),
+		}
+		for tier, want := range map[string]int64{"high": tc.high, "normal": tc.normal, "low": tc.low} {
+			if got[tier] != want {
+				t.Errorf("strict=%v: %s read %d, want %d", tc.strict, tier, got[tier], want)
+			}
+		}
+	}
+}
+
+func TestRound_RotatesStreamsWithinTier(t *testing.T) {
+	r, _, _ := newTestRunner(t)
+	r.Priority = PriorityConfig{Weights: map[string]int{redisx.TierNormal: 1}}
+	ctx := context.Background()
+	for _, s := range r.streams() {
+		_ = redisx.EnsureGroup(ctx, r.RDB, s, r.Group)
+	}
+	for i := 0; i < 5; i++ {
+		for _, s := range []string{r.Streams.Scheduled, r.Streams.Adhoc} {
+			_, _ = redisx.XAddJSON(ctx, r.RDB, s, map[string]any{"run_id": "run", "job_id": "job", "handler": "shell"})
+		}
+	}
+	for turn := 0; turn < 4; turn++ {
+		if n := r.round(ctx, r.Streams.Tiers(), turn); n != 1 {
+			t.Fatalf("turn %d read %d, want the tier's weight of 1", turn, n)
+		}
+	}
+	for _, s := range []string{r.Streams.Scheduled, r.Streams.Adhoc} {
+		if p, _ := r.RDB.XPending(ctx, s, r.Group).Result(); p.Count != 2 {
+			t.Errorf("%s read %d, want 2", s, p.Count)
+		}
+	}
+}
+
+func TestRetry_KeepsPriorityTier(t *testing.T) {
+	r, mock, mr := newTestRunner(t)
+	r.Streams.High = "jobs:high"
+	ctx := context.Background()
+
+	// no free slot: the high run is deferred and remembers its tier
+	if ok, _ := r.Slots.Acquire(ctx, "job", 1, "other-run", "other-worker"); !ok {
+		t.Fatal("setup acquire")
+	}
+	mock.ExpectQuery(`FROM job_runs\s+WHERE run_id = \$1`).WillReturnRows(runRow(jobs.StatusQueued))
+	m := message(r.Streams.High, map[string]any{"run_id": "run", "job_id": "job", "handler": "shell", "max_concurrency": float64(1)})
+	if err := r.processMessage(ctx, m.Stream, m); err != nil {
+		t.Fatal(err)
+	}
+	members, err := mr.ZMembers("jobs:retry:delayed")
+	if err != nil || len(members) != 1 {
+		t.Fatalf("delayed = %v (%v)", members, err)
+	}
+	var p map[string]any
+	_ = json.Unmarshal([]byte(members[0]), &p)
+	if p["priority"] != "high" {
+		t.Fatalf("deferred run lost its tier: %v", p["priority"])
+	}
+
+	// once promoted to the retry stream it goes back to the high stream
+	m = message(r.Streams.Retry, p)
+	if err := r.processMessage(ctx, m.Stream, m); err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+	if high, err := mr.Stream(r.Streams.High); err != nil || len(high) != 1 {
+		t.Fatalf("high stream has %d entries (%v), want the retried run", len(high), err)
+	}
+}
+
+func TestQueues_RetriesStayInQueue(t *testing.T) {
+	r, _, mr := newTestRunner(t)
+	r.Streams.RetryDelayed = "jobs:retry:delayed"
+	r.Queues = []string{"gpu"}
+
+	got := r.streams()
+	want := []string{"jobs:scheduled:gpu", "jobs:adhoc:gpu", "jobs:retry:gpu"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("streams = %v, want %v", got, want)
+	}
+
+	// an early retry read from the queue's retry stream waits in the
+	// queue's delayed set, not the default one
+	due := time.Now().Add(time.Hour).UnixMilli()
+	m := message("jobs:retry:gpu", map[string]any{"run_id": "run", "job_id": "job", "handler": "shell", "available_at_ms": float64(due)})
+	if err := r.processMessage(context.Background(), m.Stream, m); err != nil {
+		t.Fatal(err)
+	}
+	if mr.Exists("jobs:retry:delayed") {
+		t.Fatalf("retry scheduled in the default queue")
+	}
+	if n, _ := r.RDB.ZCard(context.Background(), "jobs:retry:delayed:gpu").Result(); n != 1 {
+		t.Fatalf("gpu delayed set has %d entries, want 1", n)
+	}
+}
+
+func TestDeadLetter_RecordsQueueStreamAndPriority(t *testing.T) {
+	r, mock, mr := newTestRunner(t)
+	r.Streams.High = "jobs:high"
+	r.Queues = []string{"gpu"}
+
+	mock.ExpectQuery(`FROM job_runs\s+WHERE run_id = \$1`).WillReturnRows(runRow(jobs.StatusQueued))
+	mock.ExpectQuery(`UPDATE job_runs`).WillReturnRows(runRow(jobs.StatusRunning))
+	mock.ExpectExec(`INSERT INTO run_outputs`).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(`UPDATE job_runs`).WillReturnRows(runRow(jobs.StatusFailed))
+
+	m := message("jobs:high:gpu", map[string]any{"run_id": "run", "job_id": "job", "handler": "shell", "args": map[string]any{}})
+	if err := r.processMessage(context.Background(), m.Stream, m); err != nil {
+		t.Fatal(err)
+	}
+	dlq, err := mr.Stream(r.Streams.DLQ)
+	if err != nil || len(dlq) != 1 {
+		t.Fatalf("want 1 DLQ entry, got %d (%v)", len(dlq), err)
+	}
+	var p map[string]any
+	if err := json.Unmarshal([]byte(dlq[0].Values[1]), &p); err != nil {
+		t.Fatal(err)
+	}
+	if p["queue"] != "gpu" || p["stream"] != "jobs:high:gpu" || p["priority"] != "high" {
+		t.Fatalf("DLQ entry queue=%v stream=%v priority=%v", p["queue"], p["stream"], p["priority"])
+	}
+	// requeue-dlq routes it back to the same stream
+	if got := r.Streams.Route(r.Streams.Adhoc, p["queue"].(string), p["priority"].(string)); got != "jobs:high:gpu" {
+		t.Fatalf("requeued to %s", got)
+	}
+}
+
+func TestProcessMessage_LimitsComeFromJobRow(t *testing.T) {
+	r, mock, mr := newTestRunner(t)
+	ctx := context.Background()
+	if ok, _ := r.Slots.Acquire(ctx, "job", 1, "other-run", "other-worker"); !ok {
+		t.Fatal("setup acquire")
+	}
+
+	// both runs were enqueued when the job allowed 5 at a time; it was
+	// lowered to 1 since, and the row is read once for both
+	mock.ExpectQuery(`FROM job_runs\s+WHERE run_id = \$1`).WillReturnRows(runRow(jobs.StatusQueued))
+	expectJobLimits(mock, 1)
+	mock.ExpectQuery(`FROM job_runs\s+WHERE run_id = \$1`).WillReturnRows(runRow(jobs.StatusQueued))
+	for _, run := range []string{"run", "run-2"} {
+		err := r.processMessage(ctx, r.Streams.Adhoc, message(r.Streams.Adhoc, map[string]any{
+			"run_id": run, "job_id": "job", "handler": "shell", "args": map[string]any{"command": "true"},
+			"max_concurrency": float64(5),
+		}))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+	if members, _ := mr.ZMembers("jobs:retry:delayed"); len(members) != 2 {
+		t.Fatalf("%d runs deferred, want both", len(members))
+	}
+}
+
+func TestSlotWait_TinyWaitHasNoJitter(t *testing.T) {
+	r := &Runner{SlotWait: time.Nanosecond}
+	if got := r.slotWait(); got != time.Nanosecond {
+		t.Fatalf("slotWait = %v, want 1ns", got)
+	}
+}
+
+func TestThrottle_IgnoresZeroLimit(t *testing.T) {
+	r, _, _ := newTestRunner(t)
+	job := &jobs.RateLimit{Limit: 0, PeriodMS: 60000}
+	for i := 0; i < 3; i++ {
+		if by, wait, err := r.throttle(context.Background(), "job", "shell", map[string]any{}, job); err != nil || wait != 0 {
+			t.Fatalf("attempt %d: throttled by %q for %v: %v", i, by, wait, err)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package worker
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+
+	redisx "github.com/rishansujesh/job-scheduler/internal/redis"
+)
+
+// DefaultSlotTTL is the lease on a concurrency slot. Holders renew it every
+// third of the TTL, so a crashed worker's slot frees up within one TTL.
+const DefaultSlotTTL = 60 * time.Second
+
+// slotWait is how long a run without a free slot waits before trying again.
+func (r *Runner) slotWait() time.Duration {
+	wait := r.SlotWait
+	if wait <= 0 {
+		wait = 2 * time.Second
+	}
+	// spread retries of runs queued behind the same slots
+	if j := wait / 2; j > 0 {
+		wait += rand.N(j)
+	}
+	return wait
+}
+
+// deferRun puts a run that may not start yet (no concurrency slot, or
+// throttled) back in the delayed queue for wait. The attempt counter is
+// untouched: waiting is not failing.
+func (r *Runner) deferRun(ctx context.Context, stream string, m redisx.DecodedMessage, wait time.Duration) error {
+	if err := r.queueOf(stream).delayed.Schedule(ctx, r.withPriority(stream, m.Payload), time.Now().Add(wait)); err != nil {
+		return err
+	}
+	_, _ = redisx.Ack(ctx, r.RDB, stream, r.Group, m.ID)
+	return nil
+}
+
+// holdSlot renews the run's slot lease until the returned func is called,
+// which also releases the slot.
+func (r *Runner) holdSlot(ctx context.Context, jobID, runID string) func() {
+	hbCtx, stop := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		every := r.Slots.TTL / 3
+		if every <= 0 {
+			every = DefaultSlotTTL / 3
+		}
+		t := time.NewTicker(every)
+		defer t.Stop()
+		for {
+			select {
+			case <-hbCtx.Done():
+				return
+			case <-t.C:
+				if ok, err := r.Slots.Refresh(hbCtx, jobID, runID); err == nil && !ok {
+					r.Logger.Printf("slot lease lost job=%s run=%s", jobID, runID)
+				}
+			}
+		}
+	}()
+	return func() {
+		stop()
+		<-done
+		// ctx may already be cancelled (shutdown); release regardless
+		rctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := r.Slots.Release(rctx, jobID, runID); err != nil {
+			r.Logger.Printf("release slot job=%s run=%s: %v", jobID, runID, err)
+		}
+	}
+}
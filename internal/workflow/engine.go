@@ -0,0 +1,181 @@
+// Package workflow runs workflow DAGs: it enqueues a workflow's root jobs and,
+// each time a node's run finishes, the downstream jobs whose trigger rules are
+// now met.
+package workflow
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+
+	"github.com/rishansujesh/job-scheduler/internal/jobs"
+	"github.com/rishansujesh/job-scheduler/internal/outbox"
+	redisx "github.com/rishansujesh/job-scheduler/internal/redis"
+)
+
+// Engine enqueues node runs through the outbox, in the same transaction that
+// records them on the workflow run.
+type Engine struct {
+	DB     *sql.DB
+	Store  *jobs.Store
+	Stream string        // stream node runs are published to
+	Relay  *outbox.Relay // notified after commit; may be nil
+	// Tiers moves runs of high- and low-priority jobs off Stream; the zero
+	// value keeps every run on it. Runs of jobs in a named queue go to that
+	// queue's counterpart of the stream either way.
+	Tiers redisx.StreamsConfig
+}
+
+func NewEngine(db *sql.DB, store *jobs.Store, stream string, relay *outbox.Relay) *Engine {
+	return &Engine{DB: db, Store: store, Stream: stream, Relay: relay}
+}
+
+// StartTx creates a run of wf inside tx and enqueues its root nodes. The
+// caller commits and then calls Relay.Notify.
+func (e *Engine) StartTx(ctx context.Context, tx *sql.Tx, wf *jobs.Workflow) (*jobs.WorkflowRun, error) {
+	run, err := e.Store.InsertWorkflowRunTx(ctx, tx, uuid.NewString(), wf.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range wf.Nodes {
+		run.Nodes = append(run.Nodes, jobs.WorkflowRunNode{JobID: n.JobID, Status: jobs.NodePending})
+	}
+	if err := e.stepTx(ctx, tx, wf, run); err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// Start runs the workflow now. Disabled workflows return jobs.ErrNotFound.
+func (e *Engine) Start(ctx context.Context, workflowID string) (*jobs.WorkflowRun, error) {
+	tx, err := e.DB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	wf, err := e.Store.GetWorkflowTx(ctx, tx, workflowID)
+	if err != nil {
+		return nil, err
+	}
+	if !wf.Enabled {
+		return nil, jobs.ErrNotFound
+	}
+	run, err := e.StartTx(ctx, tx, wf)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	e.Relay.Notify()
+	return run, nil
+}
+
+// Advance re-evaluates a workflow run after one of its node runs reached a
+// final status. It is idempotent, so redelivered messages may call it again.
+func (e *Engine) Advance(ctx context.Context, workflowRunID string) error {
+	tx, err := e.DB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	run, err := e.Store.LockWorkflowRunTx(ctx, tx, workflowRunID)
+	if err == jobs.ErrNotFound {
+		return nil // workflow deleted meanwhile
+	}
+	if err != nil {
+		return err
+	}
+	if run.Status != jobs.WorkflowRunning {
+		return nil
+	}
+	wf, err := e.Store.GetWorkflowTx(ctx, tx, run.WorkflowID)
+	if err != nil {
+		return err
+	}
+	if err := e.stepTx(ctx, tx, wf, run); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	e.Relay.Notify()
+	return nil
+}
+
+// stepTx enqueues every node that can start, skips those that never will and
+// closes the run once all nodes are done.
+func (e *Engine) stepTx(ctx context.Context, tx *sql.Tx, wf *jobs.Workflow, run *jobs.WorkflowRun) error {
+	states := run.States()
+	for {
+		start, skip := wf.Step(states)
+		if len(start) == 0 && len(skip) == 0 {
+			break
+		}
+		for _, id := range skip {
+			if err := e.Store.SetWorkflowNodeTx(ctx, tx, run.ID, id, nil, true); err != nil {
+				return err
+			}
+			states[id] = jobs.NodeSkipped
+			setNode(run, id, nil, jobs.NodeSkipped)
+		}
+		for _, id := range start {
+			runID, st, err := e.enqueueTx(ctx, tx, run, id)
+			if err != nil {
+				return err
+			}
+			states[id] = st
+			setNode(run, id, runID, st)
+		}
+	}
+	if st, done := wf.Outcome(states); done {
+		if err := e.Store.FinishWorkflowRunTx(ctx, tx, run.ID, st); err != nil {
+			return err
+		}
+		run.Status = st
+	}
+	return nil
+}
+
+// enqueueTx inserts the node's run and its message. A disabled job is
+// skipped rather than run.
+func (e *Engine) enqueueTx(ctx context.Context, tx *sql.Tx, run *jobs.WorkflowRun, jobID string) (*string, jobs.JobRunStatus, error) {
+	job, err := e.Store.GetJobTx(ctx, tx, jobID)
+	if err != nil && err != jobs.ErrNotFound {
+		return nil, "", err
+	}
+	if job == nil || !job.Enabled {
+		return nil, jobs.NodeSkipped, e.Store.SetWorkflowNodeTx(ctx, tx, run.ID, jobID, nil, true)
+	}
+
+	runID := uuid.NewString()
+	if _, err := e.Store.InsertRunTx(ctx, tx, jobs.InsertRunParams{
+		JobID:  job.ID,
+		RunID:  runID,
+		Status: jobs.StatusQueued,
+		// one run per node per workflow run
+		IdempotencyKey: run.ID + ":" + job.ID,
+	}); err != nil {
+		return nil, "", err
+	}
+	payload := job.RunMessage(runID)
+	payload["workflow_run_id"] = run.ID
+	if _, err := outbox.Enqueue(ctx, tx, e.Tiers.Route(e.Stream, job.Queue, string(job.Priority)), payload); err != nil {
+		return nil, "", err
+	}
+	return &runID, jobs.StatusQueued, e.Store.SetWorkflowNodeTx(ctx, tx, run.ID, jobID, &runID, false)
+}
+
+// setNode keeps run.Nodes in step with what was just recorded.
+func setNode(run *jobs.WorkflowRun, jobID string, runID *string, st jobs.JobRunStatus) {
+	for i := range run.Nodes {
+		if run.Nodes[i].JobID == jobID {
+			run.Nodes[i].RunID, run.Nodes[i].Status = runID, st
+			return
+		}
+	}
+	run.Nodes = append(run.Nodes, jobs.WorkflowRunNode{JobID: jobID, RunID: runID, Status: st})
+}
@@ -0,0 +1,111 @@
+package workflow
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"github.com/rishansujesh/job-scheduler/internal/jobs"
+)
+
+var jobCols = []string{"id", "name", "type", "handler", "args", "enabled", "retry_policy", "max_concurrency", "priority", "queue", "rate_limit", "created_at", "updated_at"}
+
+var runCols = []string{"id", "job_id", "run_id", "started_at", "finished_at", "status", "attempts", "error_text", "error_class", "worker_id", "idempotency_key", "scheduled_for", "throttle_count", "throttled_at", "throttled_by"}
+
+// payloadArg captures the outbox payload so the test can inspect it.
+type payloadArg struct{ m map[string]any }
+
+func (p *payloadArg) Match(v driver.Value) bool {
+	s, ok := v.(string)
+	return ok && json.Unmarshal([]byte(s), &p.m) == nil
+}
+
+// expectRun mocks LockWorkflowRunTx and GetWorkflowTx for a chain a -> b with
+// b's trigger rule set to rule. nodes rows are job_id, run_id, skipped, status.
+func expectRun(mock sqlmock.Sqlmock, rule jobs.TriggerRule, nodes *sqlmock.Rows) {
+	mock.ExpectBegin()
+	mock.ExpectQuery(`FROM workflow_runs\s+WHERE id = \$1 FOR UPDATE`).WithArgs("wr").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "workflow_id", "status", "started_at", "finished_at"}).
+			AddRow("wr", "wf", "running", time.Now(), nil))
+	mock.ExpectQuery(`FROM workflow_run_nodes n`).WithArgs("wr").WillReturnRows(nodes)
+	mock.ExpectQuery(`FROM workflows WHERE id = \$1`).WithArgs("wf").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "enabled", "created_at", "updated_at"}).
+			AddRow("wf", "chain", true, time.Now(), time.Now()))
+	mock.ExpectQuery(`FROM workflow_nodes`).WithArgs("wf").
+		WillReturnRows(sqlmock.NewRows([]string{"job_id", "trigger_rule"}).
+			AddRow("a", "all_success").AddRow("b", string(rule)))
+	mock.ExpectQuery(`FROM workflow_edges`).WithArgs("wf").
+		WillReturnRows(sqlmock.NewRows([]string{"from_job_id", "to_job_id"}).AddRow("a", "b"))
+}
+
+func nodeRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"job_id", "run_id", "skipped", "status"})
+}
+
+func TestAdvance_EnqueuesDownstreamOnSuccess(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	e := NewEngine(db, jobs.NewStore(db), "jobs:scheduled", nil)
+
+	expectRun(mock, jobs.TriggerAllSuccess, nodeRows().
+		AddRow("a", "run-a", false, "success").
+		AddRow("b", nil, false, nil))
+	mock.ExpectQuery(`FROM jobs WHERE id = \$1`).WithArgs("b").
+		WillReturnRows(sqlmock.NewRows(jobCols).
+			AddRow("b", "b", "batch", "shell", []byte(`{"command":"echo b"}`), true, nil, 0, "normal", "default", nil, time.Now(), time.Now()))
+	mock.ExpectQuery(`INSERT INTO job_runs`).
+		WithArgs("b", sqlmock.AnyArg(), "queued", nil, "wr:b").
+		WillReturnRows(sqlmock.NewRows(runCols).
+			AddRow(1, "b", "run-b", time.Now(), nil, "queued", 0, nil, nil, nil, "wr:b", nil, 0, nil, nil))
+	payload := &payloadArg{}
+	mock.ExpectQuery(`INSERT INTO outbox`).WithArgs("jobs:scheduled", payload).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectExec(`INSERT INTO workflow_run_nodes`).
+		WithArgs("wr", "b", sqlmock.AnyArg(), false).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	if err := e.Advance(context.Background(), "wr"); err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+	if payload.m["workflow_run_id"] != "wr" || payload.m["job_id"] != "b" {
+		t.Fatalf("unexpected payload %v", payload.m)
+	}
+}
+
+func TestAdvance_SkipsAndFinishesOnFailure(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	e := NewEngine(db, jobs.NewStore(db), "jobs:scheduled", nil)
+
+	expectRun(mock, jobs.TriggerAllSuccess, nodeRows().
+		AddRow("a", "run-a", false, "dead").
+		AddRow("b", nil, false, nil))
+	mock.ExpectExec(`INSERT INTO workflow_run_nodes`).
+		WithArgs("wr", "b", nil, true).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE workflow_runs SET status = \$1`).
+		WithArgs("failed", "wr").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	if err := e.Advance(context.Background(), "wr"); err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
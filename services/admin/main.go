@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -46,6 +47,7 @@ func main() {
 		"pending":     cmdPending,
 		"claim-stuck": cmdClaimStuck,
 		"requeue-dlq": cmdRequeueDLQ,
+		"slots":       cmdSlots,
 		"help": func(ctx context.Context, rdb *redis.Client, sc redisx.StreamsConfig, group, consumer string, args []string) error {
 			usage()
 			return nil
@@ -69,12 +71,15 @@ Usage:
   admin <command> [flags]
 
 Commands:
-  lag                         Show per-stream length, group pending, and per-consumer info
+  lag                         Per queue: depth per priority tier, per-stream length,
+                              group pending, consumers and delayed retry queue depth
   pending     [--stream S]    List pending entries summary/details
   claim-stuck [--stream S] [--idle-ms 60000] [--count 100]
                               Claim messages idle longer than threshold to this consumer
   requeue-dlq [--count N] [--to-stream jobs:adhoc]
-                              Requeue N items from DLQ to another stream
+                              Requeue N items from DLQ to the stream of their
+                              queue and priority, based on --to-stream
+  slots       [--job ID]      Show runs holding per-job concurrency slots
 
 Environment (with defaults):
   REDIS_ADDR                  (redis:6379)
@@ -82,36 +87,85 @@ Environment (with defaults):
   REDIS_CONSUMER_GROUP        (cg:workers)
   REDIS_STREAM_SCHEDULED      (jobs:scheduled)
   REDIS_STREAM_ADHOC          (jobs:adhoc)
+  REDIS_STREAM_HIGH           (jobs:high)
+  REDIS_STREAM_LOW            (jobs:low)
   REDIS_STREAM_RETRY          (jobs:retry)
   REDIS_STREAM_DLQ            (jobs:dlq)
+  REDIS_RETRY_DELAYED_KEY     (jobs:retry:delayed)
+  REDIS_SLOT_PREFIX           (jobs:slots:)
+  REDIS_RATE_PREFIX           (jobs:rate:)
+  REDIS_QUEUES                ()  named queues besides default, comma-separated
 `)
 }
 
 /* -------------------- commands -------------------- */
 
 func cmdLag(ctx context.Context, rdb *redis.Client, sc redisx.StreamsConfig, group, consumer string, args []string) error {
-	streams := []string{sc.Scheduled, sc.Adhoc, sc.Retry}
-	for _, s := range streams {
-		fmt.Printf("== %s ==\n", s)
-		// XINFO STREAM LENGTH
-		info, err := rdb.XInfoStream(ctx, s).Result()
-		if err != nil {
-			fmt.Printf("  (error: %v)\n", err)
-			continue
-		}
-		fmt.Printf("  length: %d\n", info.Length)
-
-		// Group level
-		groups, _ := rdb.XInfoGroups(ctx, s).Result()
-		for _, g := range groups {
-			fmt.Printf("  group: %s  consumers=%d  pending=%d\n", g.Name, g.Consumers, g.Pending)
-			cons, _ := rdb.XInfoConsumers(ctx, s, g.Name).Result()
-			for _, c := range cons {
-				fmt.Printf("    - consumer=%s  pending=%d  idle(ms)=%d\n", c.Name, c.Pending, c.Idle)
+	for _, q := range append([]string{redisx.DefaultQueue}, sc.Queues...) {
+		fmt.Printf("=== queue %s ===\n", q)
+		lagQueue(ctx, rdb, sc.ForQueue(q), q, group)
+	}
+	return nil
+}
+
+// lagQueue prints one queue's streams, tier depths, consumers and delayed
+// retries.
+func lagQueue(ctx context.Context, rdb *redis.Client, sc redisx.StreamsConfig, queue, group string) {
+	// consumers of the worker group on any of the queue's streams
+	consumers := map[string]bool{}
+
+	// depth of a tier: messages the worker group has not read yet (lag) or
+	// not acked yet (pending), over the tier's streams
+	tiers := append(sc.Tiers(), redisx.Tier{Name: "retry", Streams: []string{sc.Retry}})
+	for _, t := range tiers {
+		var depth int64
+		for _, s := range t.Streams {
+			fmt.Printf("== %s (tier %s) ==\n", s, t.Name)
+			// XINFO STREAM LENGTH
+			info, err := rdb.XInfoStream(ctx, s).Result()
+			if err != nil {
+				fmt.Printf("  (error: %v)\n", err)
+				continue
+			}
+			fmt.Printf("  length: %d\n", info.Length)
+
+			// Group level
+			groups, _ := rdb.XInfoGroups(ctx, s).Result()
+			for _, g := range groups {
+				fmt.Printf("  group: %s  consumers=%d  pending=%d  lag=%d\n", g.Name, g.Consumers, g.Pending, g.Lag)
+				if g.Name == group {
+					depth += max(g.Lag, 0) + g.Pending
+				}
+				cons, _ := rdb.XInfoConsumers(ctx, s, g.Name).Result()
+				for _, c := range cons {
+					fmt.Printf("    - consumer=%s  pending=%d  idle(ms)=%d\n", c.Name, c.Pending, c.Idle)
+					if g.Name == group {
+						consumers[c.Name] = true
+					}
+				}
 			}
 		}
+		fmt.Printf("-- tier %s: depth=%d (group %s, unread + pending)\n", t.Name, depth, group)
+	}
+	names := make([]string, 0, len(consumers))
+	for c := range consumers {
+		names = append(names, c)
+	}
+	sort.Strings(names)
+	fmt.Printf("-- queue %s: consumers=%d %v\n", queue, len(names), names)
+
+	// Delayed retries (sorted set, promoted into the retry stream when due)
+	dq := redisx.NewDelayedQueue(rdb, sc.RetryDelayed, sc.Retry, log.Default())
+	fmt.Printf("== %s (delayed) ==\n", sc.RetryDelayed)
+	total, due, err := dq.Depth(ctx)
+	if err != nil {
+		fmt.Printf("  (error: %v)\n", err)
+		return
+	}
+	fmt.Printf("  depth: %d  due=%d\n", total, due)
+	if next, ok, err := dq.NextDue(ctx); err == nil && ok {
+		fmt.Printf("  next due in: %s\n", time.Until(next).Round(time.Millisecond))
 	}
-	return nil
 }
 
 func cmdPending(ctx context.Context, rdb *redis.Client, sc redisx.StreamsConfig, group, consumer string, args []string) error {
@@ -186,7 +240,7 @@ func cmdClaimStuck(ctx context.Context, rdb *redis.Client, sc redisx.StreamsConf
 
 func cmdRequeueDLQ(ctx context.Context, rdb *redis.Client, sc redisx.StreamsConfig, group, consumer string, args []string) error {
 	fs := flag.NewFlagSet("requeue-dlq", flag.ContinueOnError)
-	to := fs.String("to-stream", sc.Adhoc, "target stream to requeue into")
+	to := fs.String("to-stream", sc.Adhoc, "stream to requeue into, routed to each message's queue and priority")
 	count := fs.Int("count", 50, "max items to requeue")
 	if err := fs.Parse(args); err != nil {
 		return err
@@ -202,6 +256,7 @@ func cmdRequeueDLQ(ctx context.Context, rdb *redis.Client, sc redisx.StreamsConf
 		return nil
 	}
 	ok := 0
+	moved := map[string]int{}
 	for _, m := range res {
 		// Expect a "data" JSON value (what we put via XAddJSON)
 		raw, _ := getString(m.Values["data"])
@@ -215,13 +270,55 @@ func cmdRequeueDLQ(ctx context.Context, rdb *redis.Client, sc redisx.StreamsConf
 		// Reset attempt & backoff markers
 		delete(payload, "backoff_ms")
 		delete(payload, "available_at_ms")
+		delete(payload, "stream")
 		payload["attempt"] = 0
 
-		if _, err := redisx.XAddJSON(ctx, rdb, *to, payload); err == nil {
+		// back to the message's own queue, so a worker subscribed to it
+		// picks it up
+		queue, _ := getString(payload["queue"])
+		priority, _ := getString(payload["priority"])
+		target := sc.Route(*to, queue, priority)
+		if _, err := redisx.XAddJSON(ctx, rdb, target, payload); err == nil {
 			ok++
+			moved[target]++
+		}
+	}
+	fmt.Printf("requeued %d/%d messages from %s\n", ok, len(res), sc.DLQ)
+	for stream, n := range moved {
+		fmt.Printf("  -> %s: %d\n", stream, n)
+	}
+	return nil
+}
+
+func cmdSlots(ctx context.Context, rdb *redis.Client, sc redisx.StreamsConfig, group, consumer string, args []string) error {
+	fs := flag.NewFlagSet("slots", flag.ContinueOnError)
+	job := fs.String("job", "", "job id (default: all jobs holding slots)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	sem := redisx.NewSemaphore(rdb, sc.SlotPrefix, 0)
+	ids := []string{*job}
+	if *job == "" {
+		var err error
+		if ids, err = sem.Names(ctx); err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			fmt.Println("no slots held")
+			return nil
+		}
+	}
+	for _, id := range ids {
+		holders, err := sem.Holders(ctx, id)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("== job %s ==  held=%d\n", id, len(holders))
+		for _, h := range holders {
+			fmt.Printf("  - run=%s  worker=%s  expires_in=%s\n", h.Holder, h.Owner, time.Until(h.ExpiresAt).Round(time.Second))
 		}
 	}
-	fmt.Printf("requeued %d/%d messages from %s -> %s\n", ok, len(res), sc.DLQ, *to)
 	return nil
 }
 
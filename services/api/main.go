@@ -10,6 +10,8 @@ import (
 
 	"github.com/rishansujesh/job-scheduler/internal/api/server"
 	redisx "github.com/rishansujesh/job-scheduler/internal/redis"
+	"github.com/rishansujesh/job-scheduler/internal/worker/handlers"
+	"github.com/rishansujesh/job-scheduler/internal/worker/plugin"
 )
 
 func main() {
@@ -39,8 +41,20 @@ func main() {
 	}
 	defer rdb.Close()
 
+	// ---- Handlers (plugins are started on first validation) ----
+	reg := handlers.Builtin()
+	plugins, err := plugin.Load(reg, os.Getenv("HANDLER_PLUGINS"), log.Default())
+	if err != nil {
+		log.Fatalf("plugins: %v", err)
+	}
+
 	// ---- Start gRPC + REST ----
-	log.Fatal(server.StartServers(db, rdb, httpAddr, grpcAddr))
+	err = server.StartServers(db, rdb, reg, httpAddr, grpcAddr)
+	// log.Fatal skips deferred calls, so stop the plugins first
+	for _, p := range plugins {
+		_ = p.Close()
+	}
+	log.Fatal(err)
 }
 
 func getenv(k, def string) string {
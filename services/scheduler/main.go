@@ -3,21 +3,21 @@ package main
 import (
 	"context"
 	"database/sql"
-	"encoding/json"
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
 	"strconv"
 	"sync/atomic"
+	"syscall"
 	"time"
 
-	"github.com/google/uuid"
 	_ "github.com/jackc/pgx/v5/stdlib"
-	"github.com/redis/go-redis/v9"
 
 	"github.com/rishansujesh/job-scheduler/internal/jobs"
+	"github.com/rishansujesh/job-scheduler/internal/outbox"
 	redisx "github.com/rishansujesh/job-scheduler/internal/redis"
-	"github.com/rishansujesh/job-scheduler/internal/schedule"
+	"github.com/rishansujesh/job-scheduler/internal/workflow"
 )
 
 func main() {
@@ -30,7 +30,20 @@ func main() {
 
 	leaderKey := getenv("LEADER_KEY", "scheduler:leader")
 	leaderTTL := atoi(getenv("LEADER_TTL_SEC", "10"), 10)
+	if leaderTTL <= 0 {
+		leaderTTL = 10
+	}
 	httpAddr := getenv("SCHEDULER_HTTP_ADDR", ":8081")
+	// leader: one elected instance scans; shared: every instance scans,
+	// claiming due schedules with SKIP LOCKED
+	mode := getenv("SCHEDULER_MODE", "leader")
+	batchSize := atoi(getenv("SCHEDULER_BATCH_SIZE", "500"), 500)
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if mode != "leader" && mode != "shared" {
+		log.Fatalf("SCHEDULER_MODE must be leader or shared, got %q", mode)
+	}
 
 	// ---- DB ----
 	dsn := "postgres://" + pgUser + ":" + pgPass + "@" + pgHost + ":" + pgPort + "/" + pgDB + "?sslmode=disable"
@@ -39,7 +52,8 @@ func main() {
 	must0(db.Ping())
 
 	// ---- Redis ----
-	ctx := context.Background()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 	rdb, err := redisx.NewClientWithBackoff(ctx, redisx.FromEnv())
 	if err != nil {
 		log.Fatalf("redis connect failed: %v", err)
@@ -47,20 +61,33 @@ func main() {
 	defer rdb.Close()
 
 	// ---- Leader election ----
-	instanceID := hostname()
-	elect := redisx.NewLeaderElector(rdb, leaderKey, leaderTTL, instanceID)
-	elect.Start(ctx)
-	defer elect.Stop()
+	var elect *redisx.LeaderElector
+	if mode == "leader" {
+		elect = redisx.NewLeaderElector(rdb, leaderKey, leaderTTL, hostname())
+		elect.Start(ctx)
+		defer elect.Stop() // releases the lease
+	}
 
 	// ---- Scanner loop ----
 	store := jobs.NewStore(db)
+	relay := outbox.NewRelay(db, rdb, log.Default())
+	relay.BatchSize = max(relay.BatchSize, batchSize) // one pipeline per scan batch
+	go relay.Run(ctx)
+	streams := redisx.StreamsFromEnv()
+	wf := workflow.NewEngine(db, store, streams.Scheduled, relay)
+	wf.Tiers = streams
 	sc := &scanLoop{
-		DB:      db,
-		Store:   store,
-		RDB:     rdb,
-		Streams: redisx.StreamsFromEnv(),
-		Logger:  log.Default(),
-		Now:     time.Now,
+		DB:        db,
+		Store:     store,
+		RDB:       rdb,
+		Relay:     relay,
+		Streams:   streams,
+		Workflows: wf,
+		Logger:    log.Default(),
+		Now:       time.Now,
+		BatchSize: batchSize,
+		Shared:    mode == "shared",
+		Fence:     leaderKey,
 	}
 	go sc.Loop(ctx, elect)
 
@@ -69,7 +96,9 @@ func main() {
 	roleCache.Store("follower")
 	go func() {
 		for {
-			if elect.IsLeader() {
+			if elect == nil {
+				roleCache.Store("shared")
+			} else if elect.IsLeader() {
 				roleCache.Store("leader")
 			} else {
 				roleCache.Store("follower")
@@ -89,6 +118,10 @@ func main() {
 		w.Header().Set("Content-Type", "application/json")
 		_, _ = w.Write([]byte(`"` + role + `"`))
 	})
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		sc.writeMetrics(w)
+	})
 	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		_, _ = w.Write([]byte("ok"))
@@ -99,126 +132,18 @@ func main() {
 		Handler:           mux,
 		ReadHeaderTimeout: 5 * time.Second,
 	}
-	log.Printf("scheduler listening on %s", httpAddr)
-	log.Fatal(srv.ListenAndServe())
-}
-
-type scanLoop struct {
-	DB      *sql.DB
-	Store   *jobs.Store
-	RDB     *redis.Client
-	Streams redisx.StreamsConfig
-	Logger  *log.Logger
-	Now     func() time.Time
-}
-
-func (s *scanLoop) Loop(ctx context.Context, elect *redisx.LeaderElector) {
-	t := time.NewTicker(1 * time.Second)
-	defer t.Stop()
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		case <-t.C:
-			if !elect.IsLeader() {
-				continue
-			}
-			if err := s.runOnce(ctx); err != nil {
-				s.Logger.Printf("scanner error: %v", err)
-			}
-		}
-	}
-}
-
-func (s *scanLoop) runOnce(ctx context.Context) error {
-	now := s.Now().UTC()
-	rows, err := s.DB.QueryContext(ctx, `
-SELECT id, job_id, cron_expr, fixed_interval_seconds, next_run_at, timezone, last_enqueued_at, enabled
-FROM schedules
-WHERE enabled = true AND next_run_at <= $1
-ORDER BY next_run_at ASC
-LIMIT 200;`, now)
-	if err != nil {
-		return err
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var sc jobs.Schedule
-		if err := rows.Scan(&sc.ID, &sc.JobID, &sc.CronExpr, &sc.FixedIntervalSeconds, &sc.NextRunAt, &sc.Timezone, &sc.LastEnqueuedAt, &sc.Enabled); err != nil {
-			return err
+	go func() {
+		log.Printf("scheduler listening on %s", httpAddr)
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatal(err)
 		}
-		_, err := schedule.WithScheduleTxLock(ctx, s.DB, sc.ID, func(tx *sql.Tx) error {
-			// still due?
-			var stillDue bool
-			if err := tx.QueryRowContext(ctx, `
-SELECT (enabled = true AND next_run_at <= $1) FROM schedules WHERE id = $2
-`, now, sc.ID).Scan(&stillDue); err != nil {
-				return err
-			}
-			if !stillDue {
-				return nil
-			}
-
-			// load job
-			var job jobs.Job
-			var argsRaw []byte
-			if err := tx.QueryRowContext(ctx, `
-SELECT id, name, type, handler, args, enabled, created_at, updated_at
-FROM jobs WHERE id = $1 AND enabled = true`, sc.JobID).
-				Scan(&job.ID, &job.Name, &job.Type, &job.Handler, &argsRaw, &job.Enabled, &job.CreatedAt, &job.UpdatedAt); err != nil {
-				return err
-			}
-			_ = json.Unmarshal(argsRaw, &job.Args)
-
-			// idempotency + payload
-			runID := uuid.NewString()
-			idKey, err := jobs.ComputeIdempotencyKey(job.ID, sc.NextRunAt, job.Args)
-			if err != nil {
-				return err
-			}
-			payload := map[string]any{
-				"run_id":  runID,
-				"job_id":  job.ID,
-				"handler": job.Handler,
-				"args":    job.Args,
-			}
-
-			// insert run (queued)
-			store := jobs.NewStore(s.DB)
-			store.DefaultTO = 5 * time.Second
-			if _, err := store.InsertRun(ctx, jobs.InsertRunParams{
-				JobID:          job.ID,
-				RunID:          runID,
-				Status:         jobs.StatusQueued,
-				WorkerID:       nil,
-				IdempotencyKey: idKey,
-			}); err != nil {
-				return err
-			}
-
-			// enqueue
-			if _, err := redisx.XAddJSON(ctx, s.RDB, s.Streams.Scheduled, payload); err != nil {
-				return err
-			}
+	}()
 
-			// next run
-			next, err := schedule.NextRun(sc.CronExpr, sc.FixedIntervalSeconds, sc.NextRunAt, sc.Timezone)
-			if err != nil {
-				return err
-			}
-			if _, err := tx.ExecContext(ctx, `
-UPDATE schedules SET next_run_at=$1, last_enqueued_at=$2 WHERE id=$3
-`, next, now, sc.ID); err != nil {
-				return err
-			}
-			return nil
-		})
-		if err != nil {
-			return err
-		}
-	}
-	return rows.Err()
+	// on shutdown, give up the lease so another instance takes over at once
+	<-ctx.Done()
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_ = srv.Shutdown(shutdownCtx)
 }
 
 // ---------- helpers ----------
@@ -0,0 +1,403 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/rishansujesh/job-scheduler/internal/jobs"
+	"github.com/rishansujesh/job-scheduler/internal/outbox"
+	redisx "github.com/rishansujesh/job-scheduler/internal/redis"
+	"github.com/rishansujesh/job-scheduler/internal/schedule"
+	"github.com/rishansujesh/job-scheduler/internal/workflow"
+)
+
+type scanLoop struct {
+	DB        *sql.DB
+	Store     *jobs.Store
+	RDB       *redis.Client
+	Relay     *outbox.Relay
+	Streams   redisx.StreamsConfig
+	Workflows *workflow.Engine
+	Logger    *log.Logger
+	Now       func() time.Time
+
+	// BatchSize is how many due schedules (and delayed runs) one
+	// transaction claims.
+	BatchSize int
+
+	// Shared has every instance scan, claiming due schedules with SKIP
+	// LOCKED; otherwise only the elected leader scans.
+	Shared bool
+
+	// Fence is the election name under which fencing tokens are claimed in
+	// Postgres; claimed is the token last claimed.
+	Fence   string
+	claimed int64
+
+	stats scanStats
+}
+
+// scanStats are served on /metrics.
+type scanStats struct {
+	lag     atomic.Int64 // ns the oldest schedule of the last batch fired late
+	fired   atomic.Int64 // runs and workflow instances started
+	batches atomic.Int64 // batches committed
+}
+
+// Loop scans once a second, and sooner when a schedule is due before the
+// next tick, so second-granularity crons fire on time. While a backlog
+// exists it scans again at once. In leader mode it scans only while elect
+// holds the lease; elect is unused in shared mode.
+func (s *scanLoop) Loop(ctx context.Context, elect *redisx.LeaderElector) {
+	for {
+		wait := time.Second
+		var token int64
+		if !s.Shared {
+			token = elect.Token()
+		}
+		if s.Shared || token != 0 {
+			backlog, err := s.runOnce(ctx, token)
+			switch {
+			case err != nil:
+				s.Logger.Printf("scanner error: %v", err)
+				if !s.Shared && errors.Is(err, schedule.ErrStaleLeader) {
+					s.skipFence(ctx, elect)
+				}
+			case backlog:
+				wait = 0
+			default:
+				if next, err := s.Store.NextDueAt(ctx); err == nil && next != nil {
+					if d := next.Sub(s.Now()); d < wait {
+						wait = max(d, 10*time.Millisecond)
+					}
+				}
+			}
+		}
+
+		if wait == 0 {
+			if ctx.Err() != nil {
+				return
+			}
+			continue
+		}
+		t := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// skipFence has elect's next term claim a token above the one fenced in
+// Postgres. A rejected token usually belongs to a replaced leader, in which
+// case this changes nothing; but if Redis lost its token counter, new terms
+// would get small tokens and be rejected forever.
+func (s *scanLoop) skipFence(ctx context.Context, elect *redisx.LeaderElector) {
+	cur, err := schedule.FenceToken(ctx, s.DB, s.Fence)
+	if err == nil {
+		err = elect.SkipPast(ctx, cur)
+	}
+	if err != nil {
+		s.Logger.Printf("fence resync: %v", err)
+	}
+}
+
+// runOnce releases one batch of due delayed runs and fires one batch of due
+// schedules, and reports whether either batch was full.
+//
+// token is the leader's fencing token. Every transaction checks it, so
+// nothing commits once a newer leader has claimed its own. In shared mode it
+// is 0 and the row locks alone keep instances apart.
+func (s *scanLoop) runOnce(ctx context.Context, token int64) (bool, error) {
+	if token != 0 && token != s.claimed {
+		if err := schedule.ClaimFence(ctx, s.DB, s.Fence, token); err != nil {
+			return false, err
+		}
+		s.claimed = token
+	}
+	now := s.Now().UTC()
+	released, err := s.releaseDue(ctx, now, token)
+	if err != nil {
+		return false, err
+	}
+	fired, err := s.fireBatch(ctx, now, token)
+	if err != nil {
+		return false, err
+	}
+	return released == s.BatchSize || fired == s.BatchSize, nil
+}
+
+// fireBatch claims up to BatchSize due schedules and fires them in one
+// transaction. Runs, their outbox messages and the schedules' advance are
+// each written with a single statement; the relay pipelines the XADDs after
+// commit. It returns how many schedules were claimed.
+func (s *scanLoop) fireBatch(ctx context.Context, now time.Time, token int64) (int, error) {
+	tx, err := s.DB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
+	if token != 0 {
+		if err := schedule.CheckFence(ctx, tx, s.Fence, token); err != nil {
+			return 0, err
+		}
+	}
+
+	due, err := s.Store.ClaimDueSchedulesTx(ctx, tx, now, s.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	if len(due) == 0 {
+		s.stats.lag.Store(0)
+		return 0, nil
+	}
+	var jobIDs []string
+	for _, sc := range due {
+		if sc.WorkflowID == nil {
+			jobIDs = append(jobIDs, sc.JobID)
+		}
+	}
+	byID, err := s.Store.GetJobsTx(ctx, tx, jobIDs)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		runs     []jobs.InsertRunParams
+		streams  []string                        // in first-use order
+		msgs     = map[string][]map[string]any{} // per queue and tier stream
+		advances = make([]jobs.ScheduleAdvance, 0, len(due))
+		started  int
+	)
+	for _, sc := range due {
+		plan, err := s.plan(ctx, tx, sc, now)
+		if err != nil {
+			return 0, fmt.Errorf("schedule %s: %w", sc.ID, err)
+		}
+
+		fire := plan.Fire
+		switch {
+		case len(fire) == 0:
+		case sc.WorkflowID != nil:
+			ok, err := s.startWorkflow(ctx, tx, *sc.WorkflowID, len(fire))
+			if err != nil {
+				return 0, err
+			}
+			if !ok {
+				s.Logger.Printf("schedule %s: workflow %s is disabled, skipping %d occurrences", sc.ID, *sc.WorkflowID, len(fire))
+				fire = nil
+			}
+		case byID[sc.JobID] == nil || !byID[sc.JobID].Enabled:
+			s.Logger.Printf("schedule %s: job %s is disabled, skipping %d occurrences", sc.ID, sc.JobID, len(fire))
+			fire = nil
+		default:
+			job := byID[sc.JobID]
+			stream := s.Streams.Route(s.Streams.Scheduled, job.Queue, string(job.Priority))
+			if _, ok := msgs[stream]; !ok {
+				streams = append(streams, stream)
+			}
+			for _, at := range fire {
+				runID := uuid.NewString()
+				idKey, err := jobs.ComputeIdempotencyKey(job.ID, at, job.Args)
+				if err != nil {
+					return 0, err
+				}
+				runs = append(runs, jobs.InsertRunParams{
+					JobID:          job.ID,
+					RunID:          runID,
+					Status:         jobs.StatusQueued,
+					IdempotencyKey: idKey,
+				})
+				msgs[stream] = append(msgs[stream], job.RunMessage(runID))
+			}
+		}
+		started += len(fire)
+
+		// next run; fire_count advances with it, and a finished schedule
+		// is disabled
+		lastEnqueued := sc.LastEnqueuedAt
+		if len(fire) > 0 {
+			lastEnqueued = &now
+		}
+		if plan.Done {
+			s.Logger.Printf("schedule %s: past end_at or max_runs reached, disabling", sc.ID)
+		}
+		advances = append(advances, jobs.ScheduleAdvance{
+			ID: sc.ID, NextRunAt: plan.Next, LastEnqueuedAt: lastEnqueued, Fired: len(fire), Done: plan.Done,
+		})
+	}
+
+	// the runs commit in the same tx as the schedule advance; the outbox
+	// relay publishes after commit
+	inserted, err := s.Store.InsertRunsTx(ctx, tx, runs)
+	if err != nil {
+		return 0, err
+	}
+	if dup := len(runs) - len(inserted); dup > 0 {
+		s.Logger.Printf("skipped %d runs whose occurrence already has a run", dup)
+		started -= dup
+	}
+	// only runs that were inserted get a message
+	for _, stream := range streams {
+		var batch []map[string]any
+		for _, m := range msgs[stream] {
+			if id, _ := m["run_id"].(string); inserted[id] {
+				batch = append(batch, m)
+			}
+		}
+		if err := outbox.EnqueueBatch(ctx, tx, stream, batch); err != nil {
+			return 0, err
+		}
+	}
+	if err := s.Store.AdvanceSchedulesTx(ctx, tx, now, advances); err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	s.Relay.Notify()
+
+	s.stats.lag.Store(int64(now.Sub(due[0].NextRunAt)))
+	s.stats.fired.Add(int64(started))
+	s.stats.batches.Add(1)
+	return len(due), nil
+}
+
+// plan works out which of the schedule's occurrences to fire, given the
+// misfire policy and its calendars, and records what was missed or
+// suppressed.
+func (s *scanLoop) plan(ctx context.Context, tx *sql.Tx, sc jobs.Schedule, now time.Time) (schedule.Plan, error) {
+	var compiled *schedule.Calendars
+	if len(sc.CalendarIDs) > 0 {
+		cals, err := s.Store.ScheduleCalendarsTx(ctx, tx, sc.ID)
+		if err != nil {
+			return schedule.Plan{}, err
+		}
+		if compiled, err = schedule.CompileCalendars(cals); err != nil {
+			return schedule.Plan{}, err
+		}
+	}
+	plan, err := schedule.PlanDue(sc, compiled, now)
+	if err != nil {
+		return schedule.Plan{}, err
+	}
+
+	if m := plan.Missed; m.Count > 0 {
+		s.Logger.Printf("schedule %s: %d occurrences missed (%s .. %s), policy %s",
+			sc.ID, m.Count, m.First.Format(time.RFC3339), m.Last.Format(time.RFC3339), sc.MisfirePolicy)
+		if err := s.Store.InsertMisfireTx(ctx, tx, jobs.ScheduleMisfire{
+			ScheduleID: sc.ID, Policy: sc.MisfirePolicy, MissedCount: m.Count,
+			FirstMissedAt: m.First, LastMissedAt: m.Last,
+		}); err != nil {
+			return schedule.Plan{}, err
+		}
+	}
+	for _, su := range plan.Suppressed {
+		s.Logger.Printf("schedule %s: %d occurrences suppressed (%s .. %s) by calendar %s: %s",
+			sc.ID, su.Count, su.First.Format(time.RFC3339), su.Last.Format(time.RFC3339), su.CalendarID, su.Reason)
+		if err := s.Store.InsertSuppressionTx(ctx, tx, jobs.ScheduleSuppression{
+			ScheduleID: sc.ID, CalendarID: su.CalendarID, Reason: su.Reason, SuppressedCount: su.Count,
+			FirstAt: su.First, LastAt: su.Last,
+		}); err != nil {
+			return schedule.Plan{}, err
+		}
+	}
+	return plan, nil
+}
+
+// releaseDue publishes up to BatchSize delayed runs that are due on the
+// ad-hoc stream and returns how many it handled. A run whose job was
+// disabled in the meantime is canceled instead.
+func (s *scanLoop) releaseDue(ctx context.Context, now time.Time, token int64) (int, error) {
+	tx, err := s.DB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
+	if token != 0 {
+		if err := schedule.CheckFence(ctx, tx, s.Fence, token); err != nil {
+			return 0, err
+		}
+	}
+
+	due, err := s.Store.DuePendingRunsTx(ctx, tx, now, s.BatchSize)
+	if err != nil || len(due) == 0 {
+		return 0, err
+	}
+	for _, p := range due {
+		job, err := s.Store.GetJobTx(ctx, tx, p.JobID)
+		if err != nil {
+			return 0, err
+		}
+		if !job.Enabled {
+			s.Logger.Printf("delayed run %s: job %s is disabled, canceling", p.RunID, p.JobID)
+			if _, err := tx.ExecContext(ctx, `
+UPDATE job_runs SET status='canceled', finished_at=$1, error_text='job disabled' WHERE run_id=$2
+`, now, p.RunID); err != nil {
+				return 0, err
+			}
+			continue
+		}
+		job.Args = p.Args
+		if p.Priority != nil {
+			job.Priority = *p.Priority
+		}
+		stream := s.Streams.Route(s.Streams.Adhoc, job.Queue, string(job.Priority))
+		if _, err := outbox.Enqueue(ctx, tx, stream, job.RunMessage(p.RunID)); err != nil {
+			return 0, err
+		}
+		if err := s.Store.MarkReleasedTx(ctx, tx, p.RunID, now); err != nil {
+			return 0, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	s.Relay.Notify()
+	return len(due), nil
+}
+
+// startWorkflow starts n runs of the schedule's workflow. It reports false
+// if the workflow is disabled or gone.
+func (s *scanLoop) startWorkflow(ctx context.Context, tx *sql.Tx, workflowID string, n int) (bool, error) {
+	wf, err := s.Store.GetWorkflowTx(ctx, tx, workflowID)
+	if errors.Is(err, jobs.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if !wf.Enabled {
+		return false, nil
+	}
+	for i := 0; i < n; i++ {
+		if _, err := s.Workflows.StartTx(ctx, tx, wf); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// writeMetrics writes the scan stats in the Prometheus text format.
+func (s *scanLoop) writeMetrics(w io.Writer) {
+	fmt.Fprintf(w, "# HELP scheduler_lag_seconds How late the oldest schedule of the last batch fired.\n")
+	fmt.Fprintf(w, "# TYPE scheduler_lag_seconds gauge\n")
+	fmt.Fprintf(w, "scheduler_lag_seconds %g\n", time.Duration(s.stats.lag.Load()).Seconds())
+	fmt.Fprintf(w, "# HELP scheduler_fired_total Runs and workflow instances started by schedules.\n")
+	fmt.Fprintf(w, "# TYPE scheduler_fired_total counter\n")
+	fmt.Fprintf(w, "scheduler_fired_total %d\n", s.stats.fired.Load())
+	fmt.Fprintf(w, "# HELP scheduler_batches_total Batches of due schedules committed.\n")
+	fmt.Fprintf(w, "# TYPE scheduler_batches_total counter\n")
+	fmt.Fprintf(w, "scheduler_batches_total %d\n", s.stats.batches.Load())
+}
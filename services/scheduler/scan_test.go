@@ -0,0 +1,532 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/rishansujesh/job-scheduler/internal/jobs"
+	"github.com/rishansujesh/job-scheduler/internal/outbox"
+	redisx "github.com/rishansujesh/job-scheduler/internal/redis"
+	"github.com/rishansujesh/job-scheduler/internal/schedule"
+)
+
+// testDB creates a scratch database on the POSTGRES_* server, applies the
+// migrations and drops it when the test ends.
+func testDB(t testing.TB) *sql.DB {
+	t.Helper()
+	dsn := func(name string) string {
+		return "postgres://" + getenv("POSTGRES_USER", "jobs") + ":" + getenv("POSTGRES_PASSWORD", "jobs") +
+			"@" + getenv("POSTGRES_HOST", "localhost") + ":" + getenv("POSTGRES_PORT", "5432") + "/" + name + "?sslmode=disable"
+	}
+	admin, err := sql.Open("pgx", dsn(getenv("POSTGRES_DB", "jobs")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = admin.Close() })
+	name := fmt.Sprintf("scheduler_scan_test_%d", os.Getpid())
+	if _, err := admin.Exec(`CREATE DATABASE ` + name); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _, _ = admin.Exec(`DROP DATABASE IF EXISTS ` + name + ` WITH (FORCE)`) })
+
+	db, err := sql.Open("pgx", dsn(name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	files, err := filepath.Glob("../../internal/db/migrations/*.sql")
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no migrations: %v", err)
+	}
+	sort.Strings(files)
+	for _, f := range files {
+		q, err := os.ReadFile(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := db.Exec(string(q)); err != nil {
+			t.Fatalf("%s: %v", f, err)
+		}
+	}
+	return db
+}
+
+// drain scans until no backlog is left.
+// drain scans until there is no backlog, as the leader holding token or, with
+// token 0, in shared mode.
+func drain(ctx context.Context, l *scanLoop, token int64) error {
+	for {
+		backlog, err := l.runOnce(ctx, token)
+		if err != nil || !backlog {
+			return err
+		}
+	}
+}
+
+// TestSharedScan_FiresEachOccurrenceOnce runs several instances in shared
+// mode against the same schedules, all scanning at the same moments, and
+// checks that every occurrence is fired by exactly one of them.
+func TestSharedScan_FiresEachOccurrenceOnce(t *testing.T) {
+	if os.Getenv("E2E") == "" {
+		t.Skip("set E2E=1 to run end-to-end tests")
+	}
+	testConcurrentScans(t, []bool{true, true, true, true})
+}
+
+// TestMixedScan_LeaderAndSharedFireEachOccurrenceOnce covers a rolling
+// switch of SCHEDULER_MODE: the elected leader and instances already in
+// shared mode scan side by side.
+func TestMixedScan_LeaderAndSharedFireEachOccurrenceOnce(t *testing.T) {
+	if os.Getenv("E2E") == "" {
+		t.Skip("set E2E=1 to run end-to-end tests")
+	}
+	testConcurrentScans(t, []bool{false, true, true})
+}
+
+// testConcurrentScans has one instance per entry of shared scan the same
+// schedules at the same moments, and checks that every occurrence is fired
+// exactly once. An instance not shared scans as the leader.
+func testConcurrentScans(t *testing.T, shared []bool) {
+	const schedules, steps = 300, 3
+	db := testDB(t)
+	store := jobs.NewStore(db)
+	ctx := context.Background()
+
+	base := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	every := 1
+	for i := 0; i < schedules; i++ {
+		job, err := store.CreateJob(ctx, jobs.CreateJobParams{
+			Name: fmt.Sprintf("shared-%d", i), Type: "shell", Handler: "shell",
+			Args: map[string]any{"command": "true"}, Enabled: true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := store.CreateSchedule(ctx, jobs.CreateScheduleParams{
+			JobID: job.ID, FixedIntervalSeconds: &every, NextRunAt: base, Timezone: "UTC", Enabled: true,
+			MisfirePolicy: jobs.MisfireFireOnceNow, MisfireGraceSeconds: 60,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var loops []*scanLoop
+	for _, sh := range shared {
+		loops = append(loops, &scanLoop{
+			DB:        db,
+			Store:     store,
+			Streams:   redisx.StreamsFromEnv(),
+			Logger:    log.New(io.Discard, "", 0),
+			BatchSize: 25,
+			Shared:    sh,
+			Fence:     "scheduler:leader",
+		})
+	}
+	for step := 0; step < steps; step++ {
+		now := base.Add(time.Duration(step) * time.Second)
+		var wg sync.WaitGroup
+		errs := make(chan error, len(loops))
+		for _, l := range loops {
+			l.Now = func() time.Time { return now }
+			var token int64
+			if !l.Shared {
+				token = 1
+			}
+			wg.Add(1)
+			go func(l *scanLoop) {
+				defer wg.Done()
+				errs <- drain(ctx, l, token)
+			}(l)
+		}
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			if err != nil {
+				t.Fatalf("step %d: %v", step, err)
+			}
+		}
+	}
+
+	rows, err := db.Query(`
+SELECT s.fire_count, s.next_run_at, (SELECT COUNT(*) FROM job_runs r WHERE r.job_id = s.job_id)
+FROM schedules s`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	n := 0
+	for rows.Next() {
+		var (
+			fired, runs int
+			next        time.Time
+		)
+		if err := rows.Scan(&fired, &next, &runs); err != nil {
+			t.Fatal(err)
+		}
+		if fired != steps || runs != steps || !next.Equal(base.Add(steps*time.Second)) {
+			t.Errorf("fire_count %d, runs %d, next_run_at %s; want %d, %d, %s",
+				fired, runs, next, steps, steps, base.Add(steps*time.Second))
+		}
+		n++
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if n != schedules {
+		t.Fatalf("%d schedules, want %d", n, schedules)
+	}
+	var msgs int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM outbox`).Scan(&msgs); err != nil {
+		t.Fatal(err)
+	}
+	if msgs != schedules*steps {
+		t.Fatalf("%d messages, want %d", msgs, schedules*steps)
+	}
+}
+
+// TestFireBatch_DuplicateOccurrencesDoNotAbort has two schedules of one job
+// come due at the same instant, which gives their runs the same idempotency
+// key. The batch must still commit, with one run and one message.
+func TestFireBatch_DuplicateOccurrencesDoNotAbort(t *testing.T) {
+	if os.Getenv("E2E") == "" {
+		t.Skip("set E2E=1 to run end-to-end tests")
+	}
+	db := testDB(t)
+	store := jobs.NewStore(db)
+	ctx := context.Background()
+
+	base := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	every := 60
+	job, err := store.CreateJob(ctx, jobs.CreateJobParams{
+		Name: "twice", Type: "shell", Handler: "shell", Args: map[string]any{"command": "true"}, Enabled: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := store.CreateSchedule(ctx, jobs.CreateScheduleParams{
+			JobID: job.ID, FixedIntervalSeconds: &every, NextRunAt: base, Timezone: "UTC", Enabled: true,
+			MisfirePolicy: jobs.MisfireFireOnceNow, MisfireGraceSeconds: 60,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	l := &scanLoop{DB: db, Store: store, Streams: redisx.StreamsFromEnv(), Logger: log.New(io.Discard, "", 0), BatchSize: 10}
+	if n, err := l.fireBatch(ctx, base, 0); err != nil || n != 2 {
+		t.Fatalf("fireBatch: claimed %d, %v", n, err)
+	}
+	var runs, msgs, advanced int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM job_runs`).Scan(&runs); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.QueryRow(`SELECT COUNT(*) FROM outbox`).Scan(&msgs); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.QueryRow(`SELECT COUNT(*) FROM schedules WHERE next_run_at > $1`, base).Scan(&advanced); err != nil {
+		t.Fatal(err)
+	}
+	if runs != 1 || msgs != 1 || advanced != 2 {
+		t.Fatalf("runs %d, messages %d, advanced %d; want 1, 1, 2", runs, msgs, advanced)
+	}
+}
+
+// passthrough lets slice arguments (unnest($1::text[])) reach sqlmock the way
+// the pgx driver accepts them.
+type passthrough struct{}
+
+func (passthrough) ConvertValue(v any) (driver.Value, error) {
+	if dv, err := driver.DefaultParameterConverter.ConvertValue(v); err == nil {
+		return dv, nil
+	}
+	return v, nil
+}
+
+// argFunc matches an argument with a function.
+type argFunc func(driver.Value) bool
+
+func (f argFunc) Match(v driver.Value) bool { return f(v) }
+
+var (
+	jobCols      = []string{"id", "name", "type", "handler", "args", "enabled", "retry_policy", "max_concurrency", "priority", "queue", "rate_limit", "created_at", "updated_at"}
+	scheduleCols = []string{"id", "job_id", "workflow_id", "cron_expr", "fixed_interval_seconds", "next_run_at", "timezone", "last_enqueued_at", "enabled",
+		"misfire_policy", "misfire_grace_seconds", "max_catchup", "interval_mode", "interval_anchor",
+		"start_at", "end_at", "max_runs", "paused_until", "fire_count", "calendars"}
+)
+
+func TestFireBatch_WritesInBulkSkippingDuplicateRuns(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.ValueConverterOption(passthrough{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	const jobA, jobB = "11111111-1111-1111-1111-111111111111", "22222222-2222-2222-2222-222222222222"
+	now := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+
+	due := sqlmock.NewRows(scheduleCols)
+	for i, job := range []string{jobA, jobA, jobB} {
+		due.AddRow(fmt.Sprintf("s%d", i), job, nil, nil, 60, now, "UTC", nil, true,
+			"fire_once_now", 60, 10, "elapsed", now, nil, nil, nil, nil, 0, "")
+	}
+	mock.ExpectBegin()
+	mock.ExpectQuery(`FROM schedules\s+WHERE enabled = true AND next_run_at <= \$1\s+ORDER BY next_run_at ASC\s+LIMIT \$2\s+FOR UPDATE SKIP LOCKED`).
+		WithArgs(now, 100).WillReturnRows(due)
+	mock.ExpectQuery(`FROM jobs WHERE id = ANY`).WillReturnRows(sqlmock.NewRows(jobCols).
+		AddRow(jobA, "a", "shell", "shell", []byte(`{"command":"true"}`), true, nil, 0, "normal", "default", nil, now, now).
+		AddRow(jobB, "b", "shell", "shell", []byte(`{}`), false, nil, 0, "normal", "default", nil, now, now))
+	// one statement each for all runs, messages and advances; the disabled
+	// job's schedule advances without a run. Both runs of jobA have the same
+	// idempotency key, so the insert keeps only the first.
+	inserted := sqlmock.NewRows([]string{"run_id"})
+	var firstRun string
+	mock.ExpectQuery(`INSERT INTO job_runs .+ ON CONFLICT \(idempotency_key\) DO NOTHING\s+RETURNING run_id`).
+		WithArgs(argFunc(func(v driver.Value) bool {
+			ids, ok := v.([]string)
+			return ok && len(ids) == 2 && ids[0] == jobA && ids[1] == jobA
+		}), argFunc(func(v driver.Value) bool {
+			// the rows are read after the args match
+			ids, ok := v.([]string)
+			if ok && len(ids) == 2 {
+				firstRun = ids[0]
+				inserted.AddRow(firstRun)
+			}
+			return ok
+		}), sqlmock.AnyArg(), sqlmock.AnyArg(), argFunc(func(v driver.Value) bool {
+			keys, ok := v.([]string)
+			return ok && len(keys) == 2 && keys[0] == keys[1]
+		})).
+		WillReturnRows(inserted)
+	mock.ExpectExec(`INSERT INTO outbox`).
+		WithArgs("jobs:scheduled", argFunc(func(v driver.Value) bool {
+			msgs, ok := v.([]string)
+			return ok && len(msgs) == 1 && strings.Contains(msgs[0], firstRun)
+		})).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE schedules s`).
+		WithArgs(sqlmock.AnyArg(), argFunc(func(v driver.Value) bool {
+			next, ok := v.([]time.Time)
+			return ok && len(next) == 3 && next[2].Equal(now.Add(time.Minute))
+		}), sqlmock.AnyArg(), argFunc(func(v driver.Value) bool {
+			fired, ok := v.([]int32)
+			return ok && fmt.Sprint(fired) == "[1 1 0]"
+		}), sqlmock.AnyArg(), now).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectCommit()
+
+	s := &scanLoop{
+		DB:        db,
+		Store:     jobs.NewStore(db),
+		Streams:   redisx.StreamsConfig{Scheduled: "jobs:scheduled"},
+		Logger:    log.New(io.Discard, "", 0),
+		BatchSize: 100,
+	}
+	n, err := s.fireBatch(context.Background(), now, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 3 || s.stats.fired.Load() != 1 {
+		t.Fatalf("claimed %d, fired %d", n, s.stats.fired.Load())
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// BenchmarkDrain fires a backlog of schedules that all came due at once
+// (SCHEDULER_BENCH_SCHEDULES, 100000 by default): first with the old
+// loop, one transaction and one statement per write per schedule, then with
+// the batched scan at several batch sizes.
+func BenchmarkDrain(b *testing.B) {
+	if os.Getenv("E2E") == "" {
+		b.Skip("set E2E=1 to run end-to-end benchmarks")
+	}
+	n := atoi(getenv("SCHEDULER_BENCH_SCHEDULES", "100000"), 100000)
+	db := testDB(b)
+	ctx := context.Background()
+	if _, err := db.Exec(`
+INSERT INTO jobs (name, type, handler, args)
+SELECT 'bench-' || i, 'shell', 'shell', '{"command":"true"}' FROM generate_series(1, $1) AS i`, n); err != nil {
+		b.Fatal(err)
+	}
+	if _, err := db.Exec(`
+INSERT INTO schedules (job_id, fixed_interval_seconds, next_run_at, interval_anchor)
+SELECT id, 3600, now(), now() FROM jobs`); err != nil {
+		b.Fatal(err)
+	}
+
+	newScan := func(size int) *scanLoop {
+		return &scanLoop{
+			DB:        db,
+			Store:     jobs.NewStore(db),
+			Streams:   redisx.StreamsFromEnv(),
+			Logger:    log.New(io.Discard, "", 0),
+			Now:       time.Now,
+			BatchSize: size,
+			Shared:    true,
+		}
+	}
+	// drain resets the backlog b.N times and times drainOnce on it
+	drain := func(b *testing.B, drainOnce func() time.Duration) {
+		var maxLag time.Duration
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			if _, err := db.Exec(`TRUNCATE job_runs, outbox`); err != nil {
+				b.Fatal(err)
+			}
+			if _, err := db.Exec(`UPDATE schedules SET next_run_at = now(), last_enqueued_at = NULL, fire_count = 0`); err != nil {
+				b.Fatal(err)
+			}
+			b.StartTimer()
+			maxLag = max(maxLag, drainOnce())
+		}
+		b.ReportMetric(float64(n*b.N)/b.Elapsed().Seconds(), "schedules/s")
+		b.ReportMetric(maxLag.Seconds(), "max-lag-s")
+	}
+
+	b.Run("old", func(b *testing.B) {
+		s := newScan(1)
+		drain(b, func() time.Duration {
+			var maxLag time.Duration
+			for {
+				lag, fired, err := fireOneOld(ctx, s, s.Now().UTC())
+				if err != nil {
+					b.Fatal(err)
+				}
+				if !fired {
+					return maxLag
+				}
+				maxLag = max(maxLag, lag)
+			}
+		})
+	})
+	for _, size := range []int{1, 100, 1000} {
+		b.Run(fmt.Sprintf("batch=%d", size), func(b *testing.B) {
+			s := newScan(size)
+			drain(b, func() time.Duration {
+				var maxLag time.Duration
+				for {
+					backlog, err := s.runOnce(ctx, 0)
+					if err != nil {
+						b.Fatal(err)
+					}
+					maxLag = max(maxLag, time.Duration(s.stats.lag.Load()))
+					if !backlog {
+						return maxLag
+					}
+				}
+			})
+		})
+	}
+}
+
+// fireOneOld fires one due schedule the way the scan did before batching:
+// in a transaction of its own, loading the job and inserting each run, its
+// message and the schedule's advance with a statement apiece. It returns
+// how late the schedule fired and false when none was due.
+func fireOneOld(ctx context.Context, s *scanLoop, now time.Time) (time.Duration, bool, error) {
+	tx, err := s.DB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return 0, false, err
+	}
+	defer func() { _ = tx.Rollback() }()
+	due, err := s.Store.ClaimDueSchedulesTx(ctx, tx, now, 1)
+	if err != nil || len(due) == 0 {
+		return 0, false, err
+	}
+	sc := due[0]
+	plan, err := s.plan(ctx, tx, sc, now)
+	if err != nil {
+		return 0, false, err
+	}
+	job, err := s.Store.GetJobTx(ctx, tx, sc.JobID)
+	if err != nil {
+		return 0, false, err
+	}
+	for _, at := range plan.Fire {
+		runID := uuid.NewString()
+		idKey, err := jobs.ComputeIdempotencyKey(job.ID, at, job.Args)
+		if err != nil {
+			return 0, false, err
+		}
+		if _, err := s.Store.InsertRunTx(ctx, tx, jobs.InsertRunParams{
+			JobID: job.ID, RunID: runID, Status: jobs.StatusQueued, IdempotencyKey: idKey,
+		}); err != nil {
+			return 0, false, err
+		}
+		if _, err := outbox.Enqueue(ctx, tx, s.Streams.Scheduled, job.RunMessage(runID)); err != nil {
+			return 0, false, err
+		}
+	}
+	if _, err := tx.ExecContext(ctx, `
+UPDATE schedules
+SET next_run_at=$1, last_enqueued_at=$2, fire_count=fire_count+$3, enabled = enabled AND NOT $4
+WHERE id=$5
+`, plan.Next, now, len(plan.Fire), plan.Done, sc.ID); err != nil {
+		return 0, false, err
+	}
+	return now.Sub(sc.NextRunAt), true, tx.Commit()
+}
+
+// TestRunOnce_StaleTokenSkipsPastFence has Redis lose its token counter, so
+// the new leader's token is below the fenced one. The rejected claim must
+// raise the counter past the fence and give up the lease.
+func TestRunOnce_StaleTokenSkipsPastFence(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer rdb.Close()
+	ctx := context.Background()
+
+	elect := redisx.NewLeaderElector(rdb, "scheduler:leader", 10, "a")
+	elect.Start(ctx)
+	defer elect.Stop()
+	deadline := time.Now().Add(time.Second)
+	for elect.Token() == 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if elect.Token() != 1 {
+		t.Fatalf("token = %d, want 1", elect.Token())
+	}
+
+	mock.ExpectExec(`INSERT INTO scheduler_fence`).WithArgs("scheduler:leader", int64(1)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`SELECT token FROM scheduler_fence WHERE name = \$1`).WithArgs("scheduler:leader").
+		WillReturnRows(sqlmock.NewRows([]string{"token"}).AddRow(41))
+
+	l := &scanLoop{DB: db, Logger: log.New(io.Discard, "", 0), Fence: "scheduler:leader"}
+	_, err = l.runOnce(ctx, elect.Token())
+	if !errors.Is(err, schedule.ErrStaleLeader) {
+		t.Fatalf("runOnce: %v, want ErrStaleLeader", err)
+	}
+	l.skipFence(ctx, elect)
+
+	if got, _ := mr.Get("scheduler:leader:token"); got != "41" {
+		t.Fatalf("token counter = %q, want 41", got)
+	}
+	if elect.IsLeader() || mr.Exists("scheduler:leader") {
+		t.Fatalf("lease kept with a stale token")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -6,13 +6,19 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	_ "github.com/jackc/pgx/v5/stdlib"
 
 	"github.com/rishansujesh/job-scheduler/internal/jobs"
+	"github.com/rishansujesh/job-scheduler/internal/outbox"
 	redisx "github.com/rishansujesh/job-scheduler/internal/redis"
 	"github.com/rishansujesh/job-scheduler/internal/worker"
+	"github.com/rishansujesh/job-scheduler/internal/worker/handlers"
+	"github.com/rishansujesh/job-scheduler/internal/worker/plugin"
+	"github.com/rishansujesh/job-scheduler/internal/workflow"
 )
 
 func main() {
@@ -28,6 +34,45 @@ func main() {
 	httpAddr := getenv("WORKER_HTTP_ADDR", ":8082")
 	group := getenv("REDIS_CONSUMER_GROUP", "cg:workers")
 	consumer := hostname()
+	retry := jobs.DefaultRetryPolicy()
+	retry.MaxAttempts = atoi(getenv("WORKER_MAX_ATTEMPTS", ""), retry.MaxAttempts)
+	streams := redisx.StreamsFromEnv()
+	// WORKER_QUEUES=default,gpu picks the queues this pool runs jobs of.
+	queues := redisx.SplitList(getenv("WORKER_QUEUES", redisx.DefaultQueue))
+	reaper := worker.DefaultReaperConfig()
+	reaper.Interval = time.Duration(atoi(getenv("WORKER_REAP_INTERVAL_SEC", ""), int(reaper.Interval/time.Second))) * time.Second
+	reaper.MinIdle = time.Duration(atoi(getenv("WORKER_CLAIM_MIN_IDLE_SEC", ""), int(reaper.MinIdle/time.Second))) * time.Second
+	reaper.MaxDeliveries = int64(atoi(getenv("WORKER_MAX_DELIVERIES", ""), int(reaper.MaxDeliveries)))
+	reaper.StreamMinIdle = map[string]time.Duration{}
+	for env, stream := range map[string]string{
+		"WORKER_CLAIM_MIN_IDLE_SEC_SCHEDULED": streams.Scheduled,
+		"WORKER_CLAIM_MIN_IDLE_SEC_ADHOC":     streams.Adhoc,
+		"WORKER_CLAIM_MIN_IDLE_SEC_HIGH":      streams.High,
+		"WORKER_CLAIM_MIN_IDLE_SEC_LOW":       streams.Low,
+		"WORKER_CLAIM_MIN_IDLE_SEC_RETRY":     streams.Retry,
+	} {
+		if n := atoi(getenv(env, ""), 0); n > 0 {
+			for _, q := range queues {
+				reaper.StreamMinIdle[redisx.QueueStream(stream, q)] = time.Duration(n) * time.Second
+			}
+		}
+	}
+	// WORKER_PRIORITY_WEIGHTS=high=8,normal=4,low=1 sets the messages read
+	// per tier each round; WORKER_PRIORITY_STRICT=true drains higher tiers
+	// first instead.
+	priority := worker.DefaultPriorityConfig()
+	priority.Strict = getenv("WORKER_PRIORITY_STRICT", "false") == "true"
+	priority.Consumers = atoi(getenv("WORKER_CONSUMERS", ""), priority.Consumers)
+	if v := os.Getenv("WORKER_PRIORITY_WEIGHTS"); v != "" {
+		priority.Weights = map[string]int{}
+		for _, kv := range strings.Split(v, ",") {
+			tier, w, _ := strings.Cut(kv, "=")
+			priority.Weights[strings.TrimSpace(tier)] = atoi(w, 0)
+		}
+	}
+	output := jobs.DefaultOutputLimits()
+	output.MaxBytes = atoi(getenv("WORKER_OUTPUT_MAX_BYTES", ""), output.MaxBytes)
+	output.Compress = getenv("WORKER_OUTPUT_COMPRESS", "true") == "true"
 
 	// ---- DB ----
 	dsn := "postgres://" + pgUser + ":" + pgPass + "@" + pgHost + ":" + pgPort + "/" + pgDB + "?sslmode=disable"
@@ -42,16 +87,38 @@ func main() {
 	}
 	defer rdb.Close()
 
+	// ---- Handlers ----
+	// HANDLER_PLUGINS=name=/path/to/bin,... adds out-of-process handlers.
+	reg := handlers.Builtin()
+	plugins, err := plugin.Load(reg, os.Getenv("HANDLER_PLUGINS"), log.Default())
+	if err != nil {
+		log.Fatalf("plugins: %v", err)
+	}
+	for _, p := range plugins {
+		defer p.Close()
+	}
+
 	// ---- Runner ----
+	// Workflow nodes enqueued by this worker go through the outbox too.
 	store := jobs.NewStore(db)
+	relay := outbox.NewRelay(db, rdb, log.Default())
+	go relay.Run(ctx)
+	wf := workflow.NewEngine(db, store, streams.Scheduled, relay)
+	wf.Tiers = streams
 	r := &worker.Runner{
 		DB:           db,
 		Store:        store,
 		RDB:          rdb,
-		Streams:      redisx.StreamsFromEnv(),
+		Streams:      streams,
+		Queues:       queues,
 		Group:        group,
 		ConsumerName: consumer,
-		MaxAttempts:  5,
+		Retry:        retry,
+		Output:       output,
+		Reaper:       reaper,
+		Priority:     priority,
+		Handlers:     reg,
+		Workflows:    wf,
 		Logger:       log.Default(),
 	}
 	r.Start(ctx)
@@ -73,7 +140,7 @@ func main() {
 		Handler:           mux,
 		ReadHeaderTimeout: 5 * time.Second,
 	}
-	log.Printf("worker listening on %s (group=%s consumer=%s)", httpAddr, group, consumer)
+	log.Printf("worker listening on %s (group=%s consumer=%s queues=%v)", httpAddr, group, consumer, queues)
 	log.Fatal(srv.ListenAndServe())
 }
 
@@ -84,6 +151,13 @@ func getenv(k, def string) string {
 	}
 	return def
 }
+func atoi(s string, def int) int {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return def
+	}
+	return n
+}
 func must[T any](v T, err error) T {
 	if err != nil {
 		log.Fatalf("fatal: %v", err)